@@ -3,8 +3,8 @@ package cli
 import (
 	"fmt"
 	"log"
-	"wordrop/cmd/cli/handlers"
 
+	"github.com/Go-roro/wordrop/cmd/cli/handlers"
 	ui "github.com/gizak/termui/v3"
 	"github.com/gizak/termui/v3/widgets"
 )
@@ -0,0 +1,105 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/common"
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+// PublicWordResponse is the part of a delivered word shown on the public website.
+type PublicWordResponse struct {
+	Text           string          `json:"word"`
+	EnglishMeaning string          `json:"english_meaning,omitempty"`
+	KoreanMeanings []string        `json:"korean_meaning,omitempty"`
+	Meanings       []PublicMeaning `json:"meanings,omitempty"`
+	Senses         []PublicSense   `json:"senses,omitempty"`
+	IPA            string          `json:"ipa,omitempty"`
+	AudioURL       string          `json:"audio_url,omitempty"`
+	Description    string          `json:"description,omitempty"`
+	Examples       []PublicExample `json:"examples,omitempty"`
+	Synonyms       []string        `json:"synonyms,omitempty"`
+	Level          string          `json:"level,omitempty"`
+	Tags           []string        `json:"tags,omitempty"`
+	DeliveredAt    time.Time       `json:"delivered_at"`
+}
+
+// PublicMeaning is a Korean meaning with its part of speech, if known.
+type PublicMeaning struct {
+	PartOfSpeech string `json:"part_of_speech,omitempty"`
+	Korean       string `json:"korean"`
+}
+
+// PublicSense is one meaning of a word with the examples and related words that go with it.
+type PublicSense struct {
+	PartOfSpeech      string          `json:"part_of_speech,omitempty"`
+	EnglishDefinition string          `json:"english_definition,omitempty"`
+	KoreanGlosses     []string        `json:"korean_glosses,omitempty"`
+	Examples          []PublicExample `json:"examples,omitempty"`
+	Synonyms          []string        `json:"synonyms,omitempty"`
+	Antonyms          []string        `json:"antonyms,omitempty"`
+}
+
+type PublicExample struct {
+	ExampleText string `json:"example_text"`
+	KoreanText  string `json:"korean_text,omitempty"`
+}
+
+func NewPublicWordResponse(w *word.Word) *PublicWordResponse {
+	examples := newPublicExamples(w.Examples)
+
+	meanings := make([]PublicMeaning, 0, len(w.KoreanMeanings))
+	for _, meaning := range w.Meanings() {
+		meanings = append(meanings, PublicMeaning{PartOfSpeech: string(meaning.PartOfSpeech), Korean: meaning.Text})
+	}
+
+	senses := make([]PublicSense, 0, len(w.Senses))
+	for _, sense := range w.Senses {
+		senses = append(senses, PublicSense{
+			PartOfSpeech:      string(sense.PartOfSpeech),
+			EnglishDefinition: sense.EnglishDefinition,
+			KoreanGlosses:     sense.KoreanGlosses,
+			Examples:          newPublicExamples(sense.Examples),
+			Synonyms:          sense.Synonyms,
+			Antonyms:          sense.Antonyms,
+		})
+	}
+
+	return &PublicWordResponse{
+		Text:           w.Text,
+		EnglishMeaning: w.EnglishMeaning,
+		KoreanMeanings: w.KoreanMeanings,
+		Meanings:       meanings,
+		Senses:         senses,
+		IPA:            w.IPA,
+		AudioURL:       w.AudioURL,
+		Description:    w.Description,
+		Examples:       examples,
+		Synonyms:       w.Synonyms,
+		Level:          string(w.Level),
+		Tags:           w.Tags,
+		DeliveredAt:    w.DeliveredAt,
+	}
+}
+
+func newPublicExamples(examples []word.Example) []PublicExample {
+	public := make([]PublicExample, 0, len(examples))
+	for _, example := range examples {
+		public = append(public, PublicExample{ExampleText: example.ExampleText, KoreanText: example.KoreanText})
+	}
+	return public
+}
+
+func NewPublicWordPage(words *common.PageResult[*word.Word]) *common.PageResult[*PublicWordResponse] {
+	data := make([]*PublicWordResponse, 0, len(words.Data))
+	for _, w := range words.Data {
+		data = append(data, NewPublicWordResponse(w))
+	}
+	return &common.PageResult[*PublicWordResponse]{
+		Data:       data,
+		Page:       words.Page,
+		LastPage:   words.LastPage,
+		TotalSize:  words.TotalSize,
+		NextCursor: words.NextCursor,
+	}
+}
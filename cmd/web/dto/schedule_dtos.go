@@ -0,0 +1,14 @@
+package dto
+
+type AssignScheduleRequest struct {
+	WordID string `json:"word_id" validate:"required"`
+}
+
+type ReorderScheduleRequest struct {
+	Start   string   `json:"start" validate:"required"`
+	WordIDs []string `json:"word_ids" validate:"required"`
+}
+
+type BackfillScheduleResponse struct {
+	Filled int `json:"filled"`
+}
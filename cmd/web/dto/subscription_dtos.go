@@ -1,6 +1,13 @@
 package dto
 
-import "github.com/Go-roro/wordrop/internal/subscription"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/word"
+)
 
 type SaveSubscriptionRequest struct {
 	Email    string `json:"email" validate:"required,email"`
@@ -13,3 +20,90 @@ func (r *SaveSubscriptionRequest) ToSaveDto() *subscription.SaveSubscriptionDto
 		Username: r.Username,
 	}
 }
+
+// DeliveryPreferencesRequest takes days of the week by name, e.g. ["monday", "thursday"].
+type DeliveryPreferencesRequest struct {
+	Frequency    string   `json:"frequency" validate:"required"`
+	Days         []string `json:"days,omitempty"`
+	TimesPerWeek int      `json:"times_per_week,omitempty"`
+	SendTime     string   `json:"send_time" validate:"required"`
+	Timezone     string   `json:"timezone" validate:"required"`
+	PausedFrom   string   `json:"paused_from,omitempty"`
+	PausedUntil  string   `json:"paused_until,omitempty"`
+	Levels       []string `json:"levels,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
+func (r *DeliveryPreferencesRequest) ToUpdateDto() (*subscription.UpdatePreferencesDto, error) {
+	days := make([]time.Weekday, 0, len(r.Days))
+	for _, name := range r.Days {
+		day, ok := weekdaysByName[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown day %q", name)
+		}
+		days = append(days, day)
+	}
+
+	levels, err := word.ParseLevels(r.Levels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscription.UpdatePreferencesDto{
+		Frequency:    subscription.Frequency(r.Frequency),
+		Days:         days,
+		TimesPerWeek: r.TimesPerWeek,
+		SendTime:     r.SendTime,
+		Timezone:     r.Timezone,
+		PausedFrom:   r.PausedFrom,
+		PausedUntil:  r.PausedUntil,
+		Levels:       levels,
+		Tags:         r.Tags,
+	}, nil
+}
+
+type DeliveryPreferencesResponse struct {
+	Frequency    string   `json:"frequency"`
+	Days         []string `json:"days,omitempty"`
+	TimesPerWeek int      `json:"times_per_week,omitempty"`
+	SendTime     string   `json:"send_time"`
+	Timezone     string   `json:"timezone"`
+	PausedFrom   string   `json:"paused_from,omitempty"`
+	PausedUntil  string   `json:"paused_until,omitempty"`
+	Levels       []string `json:"levels,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+}
+
+func NewDeliveryPreferencesResponse(preferences *subscription.DeliveryPreferences) *DeliveryPreferencesResponse {
+	days := make([]string, 0, len(preferences.Days))
+	for _, day := range preferences.Days {
+		days = append(days, strings.ToLower(day.String()))
+	}
+
+	levels := make([]string, 0, len(preferences.Levels))
+	for _, level := range preferences.Levels {
+		levels = append(levels, string(level))
+	}
+
+	return &DeliveryPreferencesResponse{
+		Frequency:    string(preferences.Frequency),
+		Days:         days,
+		TimesPerWeek: preferences.TimesPerWeek,
+		SendTime:     preferences.SendTime,
+		Timezone:     preferences.Timezone,
+		PausedFrom:   preferences.PausedFrom,
+		PausedUntil:  preferences.PausedUntil,
+		Levels:       levels,
+		Tags:         preferences.Tags,
+	}
+}
+
+var weekdaysByName = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
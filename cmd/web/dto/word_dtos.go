@@ -8,9 +8,15 @@ type SaveWordRequest struct {
 	Text           string         `json:"word" validate:"required"`
 	EnglishMeaning string         `json:"english_meaning,omitempty"`
 	KoreanMeanings []string       `json:"korean_meaning,omitempty"`
+	PartsOfSpeech  []string       `json:"part_of_speech,omitempty"`
+	IPA            string         `json:"ipa,omitempty"`
+	AudioURL       string         `json:"audio_url,omitempty"`
 	Description    string         `json:"description,omitempty"`
 	Examples       []word.Example `json:"examples,omitempty"`
 	Synonyms       []string       `json:"synonyms,omitempty"`
+	Level          string         `json:"level,omitempty"`
+	Tags           []string       `json:"tags,omitempty"`
+	Senses         []word.Sense   `json:"senses,omitempty"`
 }
 
 func (req *SaveWordRequest) ToSaveDto() *word.SaveWordDto {
@@ -18,9 +24,15 @@ func (req *SaveWordRequest) ToSaveDto() *word.SaveWordDto {
 		Text:           req.Text,
 		EnglishMeaning: req.EnglishMeaning,
 		KoreanMeanings: req.KoreanMeanings,
+		PartsOfSpeech:  req.PartsOfSpeech,
+		IPA:            req.IPA,
+		AudioURL:       req.AudioURL,
 		Description:    req.Description,
 		Examples:       req.Examples,
 		Synonyms:       req.Synonyms,
+		Level:          req.Level,
+		Tags:           req.Tags,
+		Senses:         req.Senses,
 	}
 }
 
@@ -29,9 +41,15 @@ type UpdateWordRequest struct {
 	Text           string         `json:"word" validate:"required"`
 	EnglishMeaning string         `json:"english_meaning,omitempty"`
 	KoreanMeanings []string       `json:"korean_meaning,omitempty"`
+	PartsOfSpeech  []string       `json:"part_of_speech,omitempty"`
+	IPA            string         `json:"ipa,omitempty"`
+	AudioURL       string         `json:"audio_url,omitempty"`
 	Description    string         `json:"description,omitempty"`
 	Examples       []word.Example `json:"examples,omitempty"`
 	Synonyms       []string       `json:"synonyms,omitempty"`
+	Level          string         `json:"level,omitempty"`
+	Tags           []string       `json:"tags,omitempty"`
+	Senses         []word.Sense   `json:"senses,omitempty"`
 }
 
 func (req *UpdateWordRequest) ToUpdateDto() *word.UpdateWordDto {
@@ -40,8 +58,30 @@ func (req *UpdateWordRequest) ToUpdateDto() *word.UpdateWordDto {
 		Text:           req.Text,
 		EnglishMeaning: req.EnglishMeaning,
 		KoreanMeanings: req.KoreanMeanings,
+		PartsOfSpeech:  req.PartsOfSpeech,
+		IPA:            req.IPA,
+		AudioURL:       req.AudioURL,
 		Description:    req.Description,
 		Examples:       req.Examples,
 		Synonyms:       req.Synonyms,
+		Level:          req.Level,
+		Tags:           req.Tags,
+		Senses:         req.Senses,
 	}
 }
+
+type ChangeStatusRequest struct {
+	Status  string `json:"status" validate:"required"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type CommentRequest struct {
+	Text string `json:"text" validate:"required"`
+}
+
+// RelateWordRequest links the word of the URL to the word with WordID. Type is synonym, antonym,
+// derived (WordID is derived from the word), base_form (the word is derived from WordID) or collocation.
+type RelateWordRequest struct {
+	WordID string `json:"word_id" validate:"required"`
+	Type   string `json:"type" validate:"required"`
+}
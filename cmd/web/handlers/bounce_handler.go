@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/Go-roro/wordrop/internal/bounce"
+	"github.com/Go-roro/wordrop/internal/subscription"
+)
+
+type BounceHandler struct {
+	SubscriptionService *subscription.Service
+	WebhookToken        string
+}
+
+type bounceResponse struct {
+	Received  int `json:"received"`
+	Suspended int `json:"suspended"`
+}
+
+func (h *BounceHandler) ReceiveNotifications(w http.ResponseWriter, r *http.Request) {
+	h.receive(w, r, bounce.ParseGeneric)
+}
+
+func (h *BounceHandler) ReceiveSNSNotifications(w http.ResponseWriter, r *http.Request) {
+	h.receive(w, r, bounce.ParseSNS)
+}
+
+func (h *BounceHandler) receive(w http.ResponseWriter, r *http.Request, parse func(io.Reader) ([]bounce.Notification, error)) {
+	token := r.URL.Query().Get("token")
+	if h.WebhookToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(h.WebhookToken)) != 1 {
+		NewHTTPError(w, "Invalid webhook token", http.StatusUnauthorized)
+		return
+	}
+
+	notifications, err := parse(r.Body)
+	if errors.Is(err, bounce.ErrSNSSubscriptionConfirmation) {
+		log.Printf("Bounce webhook needs confirmation: %v", err)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if err != nil {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	suspended, err := bounce.Apply(h.SubscriptionService, notifications)
+	if err != nil {
+		NewHTTPError(w, "Failed to process notifications", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(bounceResponse{Received: len(notifications), Suspended: suspended}); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/Go-roro/wordrop/internal/delivery"
+	"github.com/go-chi/chi/v5"
+)
+
+type DeliveryHandler struct {
+	DeliveryService *delivery.Service
+}
+
+func (h *DeliveryHandler) GetSubscriptionDeliveries(w http.ResponseWriter, r *http.Request) {
+	deliveries, err := h.DeliveryService.History(chi.URLParam(r, "id"))
+	if err != nil {
+		NewHTTPError(w, "Failed to find deliveries", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(deliveries); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/Go-roro/wordrop/internal/dictionary"
+	"github.com/go-chi/chi/v5"
+)
+
+// DictionaryHandler proposes dictionary enrichment for words. Editors accept a proposal by patching the word.
+type DictionaryHandler struct {
+	DictionaryService *dictionary.Service
+}
+
+func (h *DictionaryHandler) ProposeEnrichment(w http.ResponseWriter, r *http.Request) {
+	proposal, err := h.DictionaryService.Propose(chi.URLParam(r, "id"))
+	if errors.Is(err, dictionary.ErrWordNotFound) {
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, dictionary.ErrEntryNotFound) {
+		NewHTTPError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to look up word in the dictionary: %v", err)
+		NewHTTPError(w, "Failed to look up word in the dictionary", http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(proposal); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
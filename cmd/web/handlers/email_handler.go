@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/go-chi/chi/v5"
+)
+
+type EmailHandler struct {
+	MailSender  *email.GmailSender
+	WordService *word.Service
+}
+
+func (h *EmailHandler) PreviewEmail(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	var dailyWord *word.Word
+	if wordID := q.Get("word_id"); wordID != "" {
+		found, err := h.WordService.FindWord(wordID)
+		if err != nil {
+			NewHTTPError(w, "Word not found", http.StatusNotFound)
+			return
+		}
+		dailyWord = found
+	}
+
+	preview, err := h.MailSender.RenderPreview(chi.URLParam(r, "template"), dailyWord)
+	if errors.Is(err, email.ErrUnknownTemplate) {
+		NewHTTPError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		NewHTTPError(w, "Failed to render preview", http.StatusInternalServerError)
+		return
+	}
+
+	switch q.Get("format") {
+	case "", "html":
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(preview.HTML))
+	case "text":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(preview.Text))
+	case "json":
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(preview); err != nil {
+			NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		}
+	default:
+		NewHTTPError(w, "Invalid format, expected html, text or json", http.StatusBadRequest)
+	}
+}
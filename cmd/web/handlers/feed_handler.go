@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/feed"
+	"github.com/Go-roro/wordrop/internal/schedule"
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	feedSize   = 30
+	feedMaxAge = 15 * time.Minute
+	// calendarTimezone decides which calendar day a delivered word falls on.
+	calendarTimezone = "Asia/Seoul"
+)
+
+// FeedHandler publishes delivered words as RSS and Atom feeds for feed readers and as a calendar feed.
+type FeedHandler struct {
+	WordService     *word.Service
+	ScheduleService *schedule.Service
+}
+
+func (h *FeedHandler) GetRSS(w http.ResponseWriter, r *http.Request) {
+	h.writeFeed(w, r, "application/rss+xml; charset=utf-8", feed.RSS, feed.LastModified)
+}
+
+func (h *FeedHandler) GetAtom(w http.ResponseWriter, r *http.Request) {
+	h.writeFeed(w, r, "application/atom+xml; charset=utf-8", feed.Atom, feed.LastModified)
+}
+
+func (h *FeedHandler) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	location, err := time.LoadLocation(calendarTimezone)
+	if err != nil {
+		location = time.UTC
+	}
+
+	upcoming, err := h.ScheduleService.Upcoming(schedule.Today(time.Now()))
+	if err != nil {
+		log.Printf("Failed to find schedule for calendar: %v", err)
+		NewHTTPError(w, "Failed to retrieve schedule", http.StatusInternalServerError)
+		return
+	}
+
+	h.writeFeed(w, r, "text/calendar; charset=utf-8", func(words []*word.Word, baseURL string) ([]byte, error) {
+		events := make([]feed.Event, 0, len(words)+len(upcoming))
+		delivered := make(map[primitive.ObjectID]bool, len(words))
+		for _, deliveredWord := range words {
+			delivered[deliveredWord.ID] = true
+			events = append(events, feed.Event{Word: deliveredWord, Day: deliveredWord.DeliveredAt.In(location)})
+		}
+		for _, scheduled := range upcoming {
+			day, err := time.ParseInLocation("2006-01-02", scheduled.Date, location)
+			if err != nil || delivered[scheduled.Word.ID] {
+				continue
+			}
+			events = append(events, feed.Event{Word: scheduled.Word, Day: day})
+		}
+		return feed.ICS(events, baseURL), nil
+	}, noLastModified)
+}
+
+// noLastModified leaves the calendar to be validated by its ETag alone: it lists upcoming words too, and no
+// date tells when an assignment, reorder or unassignment last changed which words those are.
+func noLastModified([]*word.Word) time.Time {
+	return time.Time{}
+}
+
+func (h *FeedHandler) writeFeed(
+	w http.ResponseWriter,
+	r *http.Request,
+	contentType string,
+	render func(words []*word.Word, baseURL string) ([]byte, error),
+	lastModified func(words []*word.Word) time.Time,
+) {
+	words, err := h.WordService.DeliveredWords(1, feedSize)
+	if err != nil {
+		log.Printf("Failed to find delivered words for feed: %v", err)
+		NewHTTPError(w, "Failed to retrieve words", http.StatusInternalServerError)
+		return
+	}
+
+	body, err := render(words.Data, os.Getenv("APP_BASE_URL"))
+	if err != nil {
+		log.Printf("Failed to render feed: %v", err)
+		NewHTTPError(w, "Failed to render feed", http.StatusInternalServerError)
+		return
+	}
+	writeCacheable(w, r, contentType, body, feedMaxAge, lastModified(words.Data))
+}
@@ -0,0 +1,331 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Go-roro/wordrop/cmd/web/dto"
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+const (
+	managePagesDir      = "template/manage"
+	manageSessionCookie = "wordrop_manage"
+	manageSessionMaxAge = time.Hour
+)
+
+var managePageNames = []string{"request", "message", "overview", "profile", "preferences", "unsubscribe"}
+
+var frequencyLabels = map[subscription.Frequency]string{
+	subscription.FrequencyDaily:    "매일",
+	subscription.FrequencyWeekdays: "평일",
+	subscription.FrequencyWeekly:   "주 단위",
+}
+
+var weekdayLabels = []struct {
+	day   time.Weekday
+	label string
+}{
+	{time.Monday, "월"}, {time.Tuesday, "화"}, {time.Wednesday, "수"}, {time.Thursday, "목"},
+	{time.Friday, "금"}, {time.Saturday, "토"}, {time.Sunday, "일"},
+}
+
+// ManageHandler serves the "manage my subscription" pages a subscriber reaches through a magic link.
+type ManageHandler struct {
+	SubscriptionService *subscription.Service
+	pages               map[string]*template.Template
+}
+
+type option struct {
+	Value    string
+	Label    string
+	Selected bool
+}
+
+type managePage struct {
+	Title          string
+	Error          string
+	Notice         string
+	Message        string
+	Subscription   *subscription.Subscription
+	Unsubscribed   bool
+	Preferences    *dto.DeliveryPreferencesResponse
+	FrequencyLabel string
+	Frequencies    []option
+	Weekdays       []option
+	Levels         []option
+	Tags           string
+}
+
+// NewManageHandler parses the manage pages, each rendered inside the shared layout. It panics if a page cannot be parsed.
+func NewManageHandler(subscriptionService *subscription.Service) *ManageHandler {
+	pages := make(map[string]*template.Template, len(managePageNames))
+	for _, name := range managePageNames {
+		pages[name] = template.Must(template.ParseFiles(
+			filepath.Join(managePagesDir, "layout.html"),
+			filepath.Join(managePagesDir, name+".html"),
+		))
+	}
+	return &ManageHandler{SubscriptionService: subscriptionService, pages: pages}
+}
+
+func (h *ManageHandler) Overview(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	h.render(w, http.StatusOK, "overview", h.subscriptionPage("내 구독", sub))
+}
+
+func (h *ManageHandler) RequestLink(w http.ResponseWriter, r *http.Request) {
+	email := strings.TrimSpace(r.FormValue("email"))
+	if email == "" {
+		h.render(w, http.StatusBadRequest, "request", &managePage{Title: "구독 관리", Error: "이메일 주소를 입력해주세요."})
+		return
+	}
+
+	// The response is the same whether or not the address is subscribed, so the form cannot be used to probe for subscribers.
+	if err := h.SubscriptionService.SendManageLink(email); err != nil && !errors.Is(err, subscription.ErrSubscriptionNotFound) {
+		log.Printf("Failed to send manage link: %v", err)
+	}
+	h.render(w, http.StatusOK, "message", &managePage{
+		Title:   "메일을 확인해주세요",
+		Message: "구독 중인 주소라면 구독 관리 링크를 보내드렸어요. 링크는 30분 동안 한 번만 사용할 수 있어요.",
+	})
+}
+
+func (h *ManageHandler) Login(w http.ResponseWriter, r *http.Request) {
+	sessionToken, err := h.SubscriptionService.RedeemManageLink(r.URL.Query().Get("token"))
+	if err != nil {
+		log.Printf("Failed to redeem manage link: %v", err)
+		h.render(w, http.StatusUnauthorized, "request", &managePage{
+			Title: "구독 관리",
+			Error: "링크가 만료되었거나 이미 사용되었어요. 새 링크를 받아주세요.",
+		})
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     manageSessionCookie,
+		Value:    sessionToken,
+		Path:     "/manage",
+		MaxAge:   int(manageSessionMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(os.Getenv("APP_BASE_URL"), "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, "/manage", http.StatusSeeOther)
+}
+
+func (h *ManageHandler) ProfileForm(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	h.render(w, http.StatusOK, "profile", h.subscriptionPage("프로필", sub))
+}
+
+func (h *ManageHandler) UpdateProfile(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.SubscriptionService.UpdateProfile(sub, &subscription.UpdateProfileDto{Username: r.FormValue("username")})
+	page := h.subscriptionPage("프로필", sub)
+	if errors.Is(err, subscription.ErrInvalidProfile) {
+		page.Error = "이름을 입력해주세요."
+		h.render(w, http.StatusBadRequest, "profile", page)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to update profile: %v", err)
+		NewHTTPError(w, "Failed to update profile", http.StatusInternalServerError)
+		return
+	}
+
+	page.Notice = "프로필을 저장했어요."
+	h.render(w, http.StatusOK, "profile", page)
+}
+
+func (h *ManageHandler) PreferencesForm(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	h.render(w, http.StatusOK, "preferences", h.subscriptionPage("수신 설정", sub))
+}
+
+func (h *ManageHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		NewHTTPError(w, "Invalid form", http.StatusBadRequest)
+		return
+	}
+	timesPerWeek, _ := strconv.Atoi(r.FormValue("times_per_week"))
+	req := &dto.DeliveryPreferencesRequest{
+		Frequency:    r.FormValue("frequency"),
+		Days:         r.Form["days"],
+		TimesPerWeek: timesPerWeek,
+		SendTime:     r.FormValue("send_time"),
+		Timezone:     strings.TrimSpace(r.FormValue("timezone")),
+		PausedFrom:   r.FormValue("paused_from"),
+		PausedUntil:  r.FormValue("paused_until"),
+		Levels:       r.Form["levels"],
+		Tags:         strings.Split(r.FormValue("tags"), ","),
+	}
+
+	updateDto, err := req.ToUpdateDto()
+	if err == nil {
+		err = h.SubscriptionService.UpdateSubscriptionPreferences(sub, updateDto)
+		if err != nil && !errors.Is(err, subscription.ErrInvalidPreferences) {
+			log.Printf("Failed to update preferences: %v", err)
+			NewHTTPError(w, "Failed to update preferences", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	page := h.subscriptionPage("수신 설정", sub)
+	if err != nil {
+		// Keep what the subscriber entered so they can correct it.
+		page.Preferences = &dto.DeliveryPreferencesResponse{
+			Frequency:    req.Frequency,
+			Days:         req.Days,
+			TimesPerWeek: req.TimesPerWeek,
+			SendTime:     req.SendTime,
+			Timezone:     req.Timezone,
+			PausedFrom:   req.PausedFrom,
+			PausedUntil:  req.PausedUntil,
+			Levels:       req.Levels,
+			Tags:         req.Tags,
+		}
+		page.setPreferenceOptions()
+		page.Error = "수신 설정을 확인해주세요: " + err.Error()
+		h.render(w, http.StatusBadRequest, "preferences", page)
+		return
+	}
+
+	page.Notice = "수신 설정을 저장했어요."
+	h.render(w, http.StatusOK, "preferences", page)
+}
+
+func (h *ManageHandler) UnsubscribeForm(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+	h.render(w, http.StatusOK, "unsubscribe", h.subscriptionPage("구독 해지", sub))
+}
+
+func (h *ManageHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	sub, ok := h.session(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.SubscriptionService.Unsubscribe(sub); err != nil {
+		log.Printf("Failed to unsubscribe: %v", err)
+		NewHTTPError(w, "Failed to unsubscribe", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{Name: manageSessionCookie, Path: "/manage", MaxAge: -1})
+	h.render(w, http.StatusOK, "message", &managePage{
+		Title:   "구독이 해지되었어요",
+		Message: "그동안 Wordrop을 이용해주셔서 감사합니다. 더 이상 메일을 보내지 않을게요.",
+	})
+}
+
+// session resolves the subscriber from the session cookie, or renders the link request page and reports false.
+func (h *ManageHandler) session(w http.ResponseWriter, r *http.Request) (*subscription.Subscription, bool) {
+	cookie, err := r.Cookie(manageSessionCookie)
+	if err != nil {
+		h.render(w, http.StatusOK, "request", &managePage{Title: "구독 관리"})
+		return nil, false
+	}
+
+	sub, err := h.SubscriptionService.FindManagedSubscription(cookie.Value)
+	if err != nil {
+		h.render(w, http.StatusUnauthorized, "request", &managePage{
+			Title: "구독 관리",
+			Error: "세션이 만료되었어요. 구독 관리 링크를 다시 받아주세요.",
+		})
+		return nil, false
+	}
+	return sub, true
+}
+
+func (h *ManageHandler) subscriptionPage(title string, sub *subscription.Subscription) *managePage {
+	preferences := sub.Preferences
+	if preferences.Frequency == "" {
+		preferences = subscription.DefaultDeliveryPreferences()
+	}
+
+	page := &managePage{
+		Title:          title,
+		Subscription:   sub,
+		Unsubscribed:   sub.DeliveryStatus == subscription.DeliveryUnsubscribed,
+		Preferences:    dto.NewDeliveryPreferencesResponse(&preferences),
+		FrequencyLabel: frequencyLabels[preferences.Frequency],
+	}
+	page.setPreferenceOptions()
+	return page
+}
+
+// setPreferenceOptions fills the choices of the preferences form from page.Preferences.
+func (page *managePage) setPreferenceOptions() {
+	preferences := page.Preferences
+	page.Frequencies, page.Weekdays, page.Levels = nil, nil, nil
+	for _, frequency := range []subscription.Frequency{subscription.FrequencyDaily, subscription.FrequencyWeekdays, subscription.FrequencyWeekly} {
+		page.Frequencies = append(page.Frequencies, option{
+			Value:    string(frequency),
+			Label:    frequencyLabels[frequency],
+			Selected: preferences.Frequency == string(frequency),
+		})
+	}
+
+	for _, weekday := range weekdayLabels {
+		value := strings.ToLower(weekday.day.String())
+		page.Weekdays = append(page.Weekdays, option{
+			Value:    value,
+			Label:    weekday.label,
+			Selected: slices.Contains(preferences.Days, value),
+		})
+	}
+
+	for _, level := range word.Levels {
+		page.Levels = append(page.Levels, option{
+			Value:    string(level),
+			Label:    string(level),
+			Selected: slices.Contains(preferences.Levels, string(level)),
+		})
+	}
+	page.Tags = strings.Join(preferences.Tags, ", ")
+}
+
+func (h *ManageHandler) render(w http.ResponseWriter, status int, name string, page *managePage) {
+	var body bytes.Buffer
+	if err := h.pages[name].ExecuteTemplate(&body, "layout", page); err != nil {
+		log.Printf("Failed to render %s page: %v", name, err)
+		NewHTTPError(w, "Failed to render page", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = body.WriteTo(w)
+}
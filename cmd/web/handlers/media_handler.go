@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/media"
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/go-chi/chi/v5"
+)
+
+// MediaHandler uploads pictures and pronunciation recordings for words and serves the ones kept in local storage.
+type MediaHandler struct {
+	MediaService *media.Service
+}
+
+// multipartMemory is how much of an upload is held in memory; the rest goes to a temporary file.
+const multipartMemory = 1 << 20
+
+// UploadMedia stores the file sent in the "file" field of a multipart form and attaches it to the word.
+func (h *MediaHandler) UploadMedia(w http.ResponseWriter, r *http.Request) {
+	// Leave room for the rest of the form, so the file itself is what hits the size limit.
+	r.Body = http.MaxBytesReader(w, r.Body, media.MaxUploadSize+multipartMemory)
+	if err := r.ParseMultipartForm(multipartMemory); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			NewHTTPError(w, media.ErrTooLarge.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		NewHTTPError(w, "Invalid multipart form", http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		NewHTTPError(w, "file is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	link, err := h.MediaService.Upload(chi.URLParam(r, "id"), &media.Upload{
+		Filename:    path.Base(header.Filename),
+		ContentType: header.Header.Get("Content-Type"),
+		Size:        header.Size,
+		Body:        file,
+	}, editorOf(r), time.Now())
+	if err != nil {
+		writeMediaError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(link); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// GetMedia lists the attachments of a word with links valid for a limited time.
+func (h *MediaHandler) GetMedia(w http.ResponseWriter, r *http.Request) {
+	links, err := h.MediaService.Links(chi.URLParam(r, "id"), time.Now())
+	if err != nil {
+		writeMediaError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(links); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *MediaHandler) DeleteMedia(w http.ResponseWriter, r *http.Request) {
+	if err := h.MediaService.Remove(chi.URLParam(r, "id"), chi.URLParam(r, "attachmentID")); err != nil {
+		writeMediaError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ServeFile serves a file of local media storage to whoever holds a signed link to it.
+func (h *MediaHandler) ServeFile(w http.ResponseWriter, r *http.Request) {
+	file, err := h.MediaService.Open(chi.URLParam(r, "*"), r.URL.Query().Get("token"))
+	if errors.Is(err, media.ErrInvalidSignature) {
+		NewHTTPError(w, err.Error(), http.StatusForbidden)
+		return
+	}
+	if errors.Is(err, media.ErrFileNotFound) || errors.Is(err, media.ErrInvalidKey) {
+		NewHTTPError(w, "File not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to open media file: %v", err)
+		NewHTTPError(w, "Failed to open file", http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		NewHTTPError(w, "Failed to open file", http.StatusInternalServerError)
+		return
+	}
+	// The link expires, so caches must not keep serving the file past it.
+	w.Header().Set("Cache-Control", "private, max-age=300")
+	// Uploaded files are served as the type they were stored with, never as whatever a browser guesses they are.
+	w.Header().Set("Content-Type", media.ContentType(info.Name()))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, info.Name(), info.ModTime(), file)
+}
+
+func writeMediaError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, word.ErrWordNotFound):
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+	case errors.Is(err, word.ErrAttachmentNotFound):
+		NewHTTPError(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, media.ErrUnsupportedType):
+		NewHTTPError(w, err.Error(), http.StatusUnsupportedMediaType)
+	case errors.Is(err, media.ErrTooLarge):
+		NewHTTPError(w, err.Error(), http.StatusRequestEntityTooLarge)
+	case errors.Is(err, media.ErrEmptyFile):
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, word.ErrVersionConflict):
+		NewHTTPError(w, err.Error(), http.StatusConflict)
+	default:
+		log.Printf("Failed to handle media: %v", err)
+		NewHTTPError(w, "Failed to handle media", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Go-roro/wordrop/cmd/web/dto"
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// todayMaxAge is short because today's word changes as soon as the next one is delivered.
+	todayMaxAge   = 5 * time.Minute
+	archiveMaxAge = 5 * time.Minute
+	wordMaxAge    = time.Hour
+)
+
+// PublicHandler serves delivered words to the public website without admin access.
+type PublicHandler struct {
+	WordService *word.Service
+}
+
+func (h *PublicHandler) GetToday(w http.ResponseWriter, r *http.Request) {
+	todayWord, err := h.WordService.TodayWord()
+	if errors.Is(err, word.ErrWordNotFound) {
+		NewHTTPError(w, "No word has been delivered yet", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to find today's word: %v", err)
+		NewHTTPError(w, "Failed to retrieve today's word", http.StatusInternalServerError)
+		return
+	}
+
+	h.writeJSON(w, r, dto.NewPublicWordResponse(todayWord), todayMaxAge)
+}
+
+func (h *PublicHandler) GetWord(w http.ResponseWriter, r *http.Request) {
+	deliveredWord, err := h.WordService.FindDeliveredWord(chi.URLParam(r, "text"))
+	if errors.Is(err, word.ErrWordNotFound) {
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to find word: %v", err)
+		NewHTTPError(w, "Failed to retrieve word", http.StatusInternalServerError)
+		return
+	}
+
+	h.writeJSON(w, r, dto.NewPublicWordResponse(deliveredWord), wordMaxAge)
+}
+
+func (h *PublicHandler) GetArchive(w http.ResponseWriter, r *http.Request) {
+	page := 1
+	if value := r.URL.Query().Get("page"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			NewHTTPError(w, "Invalid page", http.StatusBadRequest)
+			return
+		}
+		page = parsed
+	}
+
+	words, err := h.WordService.DeliveredWords(page, 0)
+	if err != nil {
+		log.Printf("Failed to find delivered words: %v", err)
+		NewHTTPError(w, "Failed to retrieve words", http.StatusInternalServerError)
+		return
+	}
+	if page > words.LastPage {
+		NewHTTPError(w, "Page not found", http.StatusNotFound)
+		return
+	}
+
+	h.writeJSON(w, r, dto.NewPublicWordPage(words), archiveMaxAge)
+}
+
+func (h *PublicHandler) writeJSON(w http.ResponseWriter, r *http.Request, response any, maxAge time.Duration) {
+	body, err := json.Marshal(response)
+	if err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+	writeCacheable(w, r, "application/json", body, maxAge, time.Time{})
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/quiz"
+	"github.com/go-chi/chi/v5"
+)
+
+type QuizHandler struct {
+	QuizService *quiz.Service
+}
+
+func (h *QuizHandler) AnswerQuiz(w http.ResponseWriter, r *http.Request) {
+	result, err := h.QuizService.RecordAnswer(chi.URLParam(r, "token"), time.Now())
+	if err != nil {
+		log.Printf("Failed to record quiz answer: %v", err)
+		NewHTTPError(w, "Invalid or expired quiz link", http.StatusBadRequest)
+		return
+	}
+
+	question := result.Question
+	verdict := "정답이에요!"
+	if !question.Correct() {
+		verdict = fmt.Sprintf("아쉬워요. %s의 뜻은 \"%s\"예요.", question.Prompt, question.Choices[question.AnswerIndex])
+	}
+	summary := fmt.Sprintf("지금까지 %d문제 중 %d문제에 답했고, %d문제를 맞혔어요.",
+		result.Score.Total, result.Score.Answered, result.Score.Correct)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("<p>" + html.EscapeString(verdict) + "</p><p>" + html.EscapeString(summary) + "</p>"))
+}
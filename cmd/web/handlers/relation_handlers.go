@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Go-roro/wordrop/cmd/web/dto"
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/go-chi/chi/v5"
+)
+
+func (h *WordHandler) GetRelatedWordsHandler(w http.ResponseWriter, r *http.Request) {
+	related, err := h.WordService.RelatedWords(chi.URLParam(r, "id"))
+	if err != nil {
+		writeRelationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(related); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *WordHandler) RelateWordHandler(w http.ResponseWriter, r *http.Request) {
+	var req dto.RelateWordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		NewHTTPError(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	relationType, err := word.ParseRelationType(req.Type)
+	if err != nil {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	related, err := h.WordService.RelateWords(chi.URLParam(r, "id"), req.WordID, relationType, editorOf(r), time.Now())
+	if err != nil {
+		writeRelationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(related); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *WordHandler) UnrelateWordHandler(w http.ResponseWriter, r *http.Request) {
+	if err := h.WordService.UnrelateWords(chi.URLParam(r, "id"), chi.URLParam(r, "relationID")); err != nil {
+		writeRelationError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeRelationError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, word.ErrWordNotFound):
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+	case errors.Is(err, word.ErrRelationNotFound):
+		NewHTTPError(w, "Relation not found", http.StatusNotFound)
+	case errors.Is(err, word.ErrSelfRelation):
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, word.ErrRelationExists):
+		NewHTTPError(w, err.Error(), http.StatusConflict)
+	default:
+		log.Printf("Failed to handle word relations: %v", err)
+		NewHTTPError(w, "Failed to handle word relations", http.StatusInternalServerError)
+	}
+}
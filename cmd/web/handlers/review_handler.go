@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"fmt"
+	"html"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/review"
+	"github.com/go-chi/chi/v5"
+)
+
+type ReviewHandler struct {
+	ReviewService *review.Service
+}
+
+func (h *ReviewHandler) AnswerReview(w http.ResponseWriter, r *http.Request) {
+	answered, err := h.ReviewService.RecordAnswer(chi.URLParam(r, "token"), time.Now())
+	if err != nil {
+		log.Printf("Failed to record review answer: %v", err)
+		NewHTTPError(w, "Invalid or expired review link", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	message := fmt.Sprintf("응답을 기록했어요. 다음 복습은 %s에 보내드릴게요.", answered.DueAt.Format("2006-01-02"))
+	_, _ = w.Write([]byte("<p>" + html.EscapeString(message) + "</p>"))
+}
@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/go-chi/chi/v5"
+)
+
+// editorHeader names who made a change to a word, recorded in the word's revision history.
+const editorHeader = "X-Wordrop-Editor"
+
+func editorOf(r *http.Request) string {
+	return r.Header.Get(editorHeader)
+}
+
+func (h *WordHandler) GetRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	revisions, err := h.WordService.Revisions(chi.URLParam(r, "id"))
+	if err != nil {
+		writeRevisionError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(revisions); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *WordHandler) DiffRevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	from, err := strconv.Atoi(q.Get("from"))
+	if err != nil {
+		NewHTTPError(w, "Invalid from revision", http.StatusBadRequest)
+		return
+	}
+	to, err := strconv.Atoi(q.Get("to"))
+	if err != nil {
+		NewHTTPError(w, "Invalid to revision", http.StatusBadRequest)
+		return
+	}
+
+	changes, err := h.WordService.DiffRevisions(chi.URLParam(r, "id"), from, to)
+	if err != nil {
+		writeRevisionError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(changes); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// RestoreRevisionHandler puts an older revision back on a word. Like an update, it honours an If-Match header
+// with the ETag the editor last read, and fails with 412 Precondition Failed if the word changed since.
+func (h *WordHandler) RestoreRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	number, err := strconv.Atoi(chi.URLParam(r, "number"))
+	if err != nil {
+		NewHTTPError(w, "Invalid revision number", http.StatusBadRequest)
+		return
+	}
+	var version *int
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		if version, err = parseIfMatch(ifMatch); err != nil {
+			NewHTTPError(w, err.Error(), http.StatusPreconditionFailed)
+			return
+		}
+	}
+
+	restored, err := h.WordService.RestoreRevision(chi.URLParam(r, "id"), number, version, editorOf(r), time.Now())
+	if err != nil {
+		writeRevisionError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", versionETag(restored.Version))
+	if err := json.NewEncoder(w).Encode(restored); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func writeRevisionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, word.ErrWordNotFound):
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+	case errors.Is(err, word.ErrRevisionNotFound):
+		NewHTTPError(w, "Revision not found", http.StatusNotFound)
+	case errors.Is(err, word.ErrVersionConflict):
+		NewHTTPError(w, "Word was changed since it was read", http.StatusPreconditionFailed)
+	default:
+		log.Printf("Failed to handle word revisions: %v", err)
+		NewHTTPError(w, "Failed to handle word revisions", http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/Go-roro/wordrop/cmd/web/dto"
+	"github.com/Go-roro/wordrop/internal/schedule"
+	"github.com/go-chi/chi/v5"
+)
+
+// ScheduleHandler lets editors decide which word goes out on which date.
+type ScheduleHandler struct {
+	ScheduleService *schedule.Service
+}
+
+func (h *ScheduleHandler) GetUpcoming(w http.ResponseWriter, r *http.Request) {
+	upcoming, err := h.ScheduleService.Upcoming(schedule.Today(time.Now()))
+	if err != nil {
+		log.Printf("Failed to find schedule: %v", err)
+		NewHTTPError(w, "Failed to retrieve schedule", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(upcoming); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *ScheduleHandler) Assign(w http.ResponseWriter, r *http.Request) {
+	var req dto.AssignScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		NewHTTPError(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	err := h.ScheduleService.Assign(chi.URLParam(r, "date"), req.WordID, schedule.Today(time.Now()))
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ScheduleHandler) Unassign(w http.ResponseWriter, r *http.Request) {
+	err := h.ScheduleService.Unassign(chi.URLParam(r, "date"), schedule.Today(time.Now()))
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ScheduleHandler) Reorder(w http.ResponseWriter, r *http.Request) {
+	var req dto.ReorderScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		NewHTTPError(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	err := h.ScheduleService.Reorder(req.Start, req.WordIDs, schedule.Today(time.Now()))
+	if err != nil {
+		writeScheduleError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ScheduleHandler) Backfill(w http.ResponseWriter, r *http.Request) {
+	filled, err := h.ScheduleService.Backfill(schedule.Today(time.Now()))
+	if err != nil {
+		log.Printf("Failed to back-fill schedule: %v", err)
+		NewHTTPError(w, "Failed to back-fill schedule", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(dto.BackfillScheduleResponse{Filled: filled}); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func writeScheduleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, schedule.ErrInvalidDate), errors.Is(err, schedule.ErrDateInPast), errors.Is(err, schedule.ErrDuplicateWord):
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, schedule.ErrEntryNotFound), errors.Is(err, schedule.ErrWordNotFound):
+		NewHTTPError(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, schedule.ErrWordDeleted), errors.Is(err, schedule.ErrWordDelivered), errors.Is(err, schedule.ErrWordNotApproved),
+		errors.Is(err, schedule.ErrAlreadyScheduled):
+		NewHTTPError(w, err.Error(), http.StatusConflict)
+	default:
+		log.Printf("Failed to update schedule: %v", err)
+		NewHTTPError(w, "Failed to update schedule", http.StatusInternalServerError)
+	}
+}
@@ -2,11 +2,13 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/Go-roro/wordrop/cmd/web/dto"
 	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/go-chi/chi/v5"
 )
 
 type SubscriptionHandler struct {
@@ -22,6 +24,10 @@ func (h *SubscriptionHandler) SaveNewSubscription(w http.ResponseWriter, r *http
 
 	saveDto := req.ToSaveDto()
 	err := h.SubscriptionService.SaveSubscription(saveDto)
+	if errors.Is(err, subscription.ErrDeliveryComplained) {
+		NewHTTPError(w, err.Error(), http.StatusForbidden)
+		return
+	}
 	if err != nil {
 		errMessage := fmt.Errorf("failed to save subscription: %v", err)
 		NewHTTPError(w, errMessage.Error(), http.StatusInternalServerError)
@@ -39,6 +45,10 @@ func (h *SubscriptionHandler) VerifySubscription(w http.ResponseWriter, r *http.
 	}
 
 	err := h.SubscriptionService.VerifySubscription(verificationToken)
+	if errors.Is(err, subscription.ErrDeliveryComplained) {
+		NewHTTPError(w, err.Error(), http.StatusForbidden)
+		return
+	}
 	if err != nil {
 		errMessage := fmt.Errorf("failed to verify subscription: %v", err)
 		NewHTTPError(w, errMessage.Error(), http.StatusInternalServerError)
@@ -47,3 +57,77 @@ func (h *SubscriptionHandler) VerifySubscription(w http.ResponseWriter, r *http.
 
 	w.WriteHeader(http.StatusOK)
 }
+
+func (h *SubscriptionHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	preferencesToken := r.URL.Query().Get("token")
+	if preferencesToken == "" {
+		NewHTTPError(w, "Preferences token is required", http.StatusBadRequest)
+		return
+	}
+
+	preferences, err := h.SubscriptionService.GetPreferences(preferencesToken)
+	if err != nil {
+		errMessage := fmt.Errorf("failed to get preferences: %v", err)
+		NewHTTPError(w, errMessage.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(dto.NewDeliveryPreferencesResponse(preferences)); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *SubscriptionHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	preferencesToken := r.URL.Query().Get("token")
+	if preferencesToken == "" {
+		NewHTTPError(w, "Preferences token is required", http.StatusBadRequest)
+		return
+	}
+
+	var req *dto.DeliveryPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		NewHTTPError(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	updateDto, err := req.ToUpdateDto()
+	if err != nil {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.SubscriptionService.UpdatePreferences(preferencesToken, updateDto)
+	if errors.Is(err, subscription.ErrInvalidPreferences) {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		errMessage := fmt.Errorf("failed to update preferences: %v", err)
+		NewHTTPError(w, errMessage.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// ResumeDelivery lets an admin lift a bounce or spam complaint suspension.
+func (h *SubscriptionHandler) ResumeDelivery(w http.ResponseWriter, r *http.Request) {
+	err := h.SubscriptionService.ResumeDelivery(chi.URLParam(r, "id"))
+	if errors.Is(err, subscription.ErrSubscriptionNotFound) {
+		NewHTTPError(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, subscription.ErrNotSuspended) {
+		NewHTTPError(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		errMessage := fmt.Errorf("failed to resume delivery: %v", err)
+		NewHTTPError(w, errMessage.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
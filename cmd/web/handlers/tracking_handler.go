@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"encoding/json"
+	"html/template"
+	"log"
+	"net/http"
+
+	"github.com/Go-roro/wordrop/internal/tracking"
+	"github.com/go-chi/chi/v5"
+)
+
+// transparentPixel is a 1x1 transparent GIF.
+var transparentPixel = []byte{
+	0x47, 0x49, 0x46, 0x38, 0x39, 0x61, 0x01, 0x00, 0x01, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0xff, 0x21, 0xf9, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x00, 0x01, 0x00, 0x00, 0x02, 0x02, 0x44, 0x01, 0x00, 0x3b,
+}
+
+// optOutPage asks for confirmation before opting out, so that link scanners
+// and mail prefetchers following the GET do not opt the subscriber out.
+var optOutPage = template.Must(template.New("opt-out").Parse(
+	`<form method="post" action="/tracking/opt-out/{{.}}">` +
+		`<p>Wordrop 메일 열람 및 링크 클릭 추적을 거부하시겠습니까?</p>` +
+		`<button type="submit">추적 거부하기</button></form>`))
+
+type TrackingHandler struct {
+	TrackingService *tracking.Service
+}
+
+func (h *TrackingHandler) TrackOpen(w http.ResponseWriter, r *http.Request) {
+	if err := h.TrackingService.RecordOpen(chi.URLParam(r, "token")); err != nil {
+		log.Printf("Failed to record open: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "image/gif")
+	w.Header().Set("Cache-Control", "no-store, no-cache, must-revalidate")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(transparentPixel)
+}
+
+func (h *TrackingHandler) TrackClick(w http.ResponseWriter, r *http.Request) {
+	target, err := h.TrackingService.RecordClick(chi.URLParam(r, "token"))
+	if target == "" {
+		NewHTTPError(w, "Invalid tracking link", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to record click: %v", err)
+	}
+
+	http.Redirect(w, r, target, http.StatusFound)
+}
+
+func (h *TrackingHandler) OptOutForm(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err := optOutPage.Execute(w, chi.URLParam(r, "token")); err != nil {
+		log.Printf("Failed to render opt-out page: %v", err)
+	}
+}
+
+func (h *TrackingHandler) OptOut(w http.ResponseWriter, r *http.Request) {
+	if err := h.TrackingService.OptOut(chi.URLParam(r, "token")); err != nil {
+		NewHTTPError(w, "Failed to opt out of tracking", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("<p>Wordrop 메일 열람 및 링크 클릭 추적을 더 이상 하지 않습니다.</p>"))
+}
+
+func (h *TrackingHandler) GetWordSummary(w http.ResponseWriter, r *http.Request) {
+	h.writeSummary(w, &tracking.SummaryFilter{WordID: chi.URLParam(r, "id")})
+}
+
+func (h *TrackingHandler) GetSubscriptionSummary(w http.ResponseWriter, r *http.Request) {
+	h.writeSummary(w, &tracking.SummaryFilter{SubscriptionID: chi.URLParam(r, "id")})
+}
+
+func (h *TrackingHandler) writeSummary(w http.ResponseWriter, filter *tracking.SummaryFilter) {
+	summary, err := h.TrackingService.Summarize(filter)
+	if err != nil {
+		NewHTTPError(w, "Failed to summarize tracking events", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(summary); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
@@ -2,11 +2,17 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
+	"mime"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Go-roro/wordrop/cmd/web/dto"
 	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/go-chi/chi/v5"
 )
 
 type WordHandler struct {
@@ -21,7 +27,12 @@ func (h *WordHandler) SaveWordHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	saveDto := req.ToSaveDto()
+	saveDto.Editor = editorOf(r)
 	createdWord, err := h.WordService.SaveNewWord(saveDto)
+	if invalidWordInput(err) {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		NewHTTPError(w, "Failed to save word", http.StatusInternalServerError)
 		return
@@ -35,14 +46,40 @@ func (h *WordHandler) SaveWordHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UpdateWordHandler replaces what editors write on a word. An If-Match header with the ETag the editor last
+// read rejects the update if the word changed in between, as for PatchWordHandler.
 func (h *WordHandler) UpdateWordHandler(w http.ResponseWriter, r *http.Request) {
+	var version *int
+	if ifMatch := r.Header.Get("If-Match"); ifMatch != "" {
+		var err error
+		if version, err = parseIfMatch(ifMatch); err != nil {
+			NewHTTPError(w, err.Error(), http.StatusPreconditionFailed)
+			return
+		}
+	}
+
 	var req dto.UpdateWordRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		NewHTTPError(w, "Invalid JSON", http.StatusBadRequest)
 		return
 	}
 
-	err := h.WordService.UpdateWord(req.ToUpdateDto())
+	updateDto := req.ToUpdateDto()
+	updateDto.Editor = editorOf(r)
+	updateDto.Version = version
+	err := h.WordService.UpdateWord(updateDto)
+	if invalidWordInput(err) {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if errors.Is(err, word.ErrWordNotFound) {
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+		return
+	}
+	if errors.Is(err, word.ErrVersionConflict) {
+		NewHTTPError(w, "Word was changed since it was read", http.StatusPreconditionFailed)
+		return
+	}
 	if err != nil {
 		NewHTTPError(w, "Failed to update word", http.StatusInternalServerError)
 		return
@@ -51,6 +88,180 @@ func (h *WordHandler) UpdateWordHandler(w http.ResponseWriter, r *http.Request)
 	w.WriteHeader(http.StatusOK)
 }
 
+// mergePatchContentType is the media type of a JSON Merge Patch (RFC 7396).
+const mergePatchContentType = "application/merge-patch+json"
+
+const maxPatchSize = 1 << 20
+
+func (h *WordHandler) GetWordHandler(w http.ResponseWriter, r *http.Request) {
+	found, err := h.WordService.FindWord(chi.URLParam(r, "id"))
+	if err != nil || found.IsDeleted() {
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", versionETag(found.Version))
+	if err := json.NewEncoder(w).Encode(found); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// PatchWordHandler applies a JSON Merge Patch to a word. The If-Match header must carry the ETag
+// the editor last read, so an edit made in between is not silently overwritten.
+func (h *WordHandler) PatchWordHandler(w http.ResponseWriter, r *http.Request) {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" {
+		NewHTTPError(w, "If-Match header is required", http.StatusPreconditionRequired)
+		return
+	}
+	version, err := parseIfMatch(ifMatch)
+	if err != nil {
+		NewHTTPError(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != mergePatchContentType && mediaType != "application/json" {
+		NewHTTPError(w, "Content-Type must be "+mergePatchContentType, http.StatusUnsupportedMediaType)
+		return
+	}
+
+	patch, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPatchSize))
+	if err != nil {
+		NewHTTPError(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	patched, err := h.WordService.PatchWord(chi.URLParam(r, "id"), patch, version, editorOf(r), time.Now())
+	switch {
+	case errors.Is(err, word.ErrWordNotFound):
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+		return
+	case errors.Is(err, word.ErrVersionConflict):
+		NewHTTPError(w, "Word was changed since it was read", http.StatusPreconditionFailed)
+		return
+	case errors.Is(err, word.ErrInvalidPatch), invalidWordInput(err):
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		NewHTTPError(w, "Failed to update word", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", versionETag(patched.Version))
+	if err := json.NewEncoder(w).Encode(patched); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+// invalidWordInput reports whether err is a word rejected for what the editor wrote.
+func invalidWordInput(err error) bool {
+	return errors.Is(err, word.ErrTextRequired) || errors.Is(err, word.ErrInvalidLevel) ||
+		errors.Is(err, word.ErrInvalidPartOfSpeech) || errors.Is(err, word.ErrInvalidAudioURL)
+}
+
+func versionETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// parseIfMatch reads the word version out of an If-Match header. It returns nil for "*", which matches any version.
+func parseIfMatch(value string) (*int, error) {
+	value = strings.TrimSpace(value)
+	if value == "*" {
+		return nil, nil
+	}
+
+	unquoted, ok := strings.CutPrefix(value, `"`)
+	if ok {
+		unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	}
+	version, err := strconv.Atoi(unquoted)
+	if !ok || err != nil {
+		return nil, errors.New("invalid If-Match header, expected a single strong ETag")
+	}
+	return &version, nil
+}
+
+func (h *WordHandler) ChangeStatusHandler(w http.ResponseWriter, r *http.Request) {
+	var req dto.ChangeStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		NewHTTPError(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+	status, err := word.ParseStatus(req.Status)
+	if err != nil {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	changed, err := h.WordService.ChangeStatus(chi.URLParam(r, "id"), status, editorOf(r), req.Comment, time.Now())
+	if err != nil {
+		writeWorkflowError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", versionETag(changed.Version))
+	if err := json.NewEncoder(w).Encode(changed); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func (h *WordHandler) AddCommentHandler(w http.ResponseWriter, r *http.Request) {
+	var req dto.CommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		NewHTTPError(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	commented, err := h.WordService.AddComment(chi.URLParam(r, "id"), editorOf(r), req.Text, time.Now())
+	if err != nil {
+		writeWorkflowError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(commented.Comments); err != nil {
+		NewHTTPError(w, "Failed to encode response", http.StatusInternalServerError)
+		return
+	}
+}
+
+func writeWorkflowError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, word.ErrWordNotFound):
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+	case errors.Is(err, word.ErrInvalidTransition), errors.Is(err, word.ErrVersionConflict):
+		NewHTTPError(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, word.ErrEmptyComment), errors.Is(err, word.ErrReviewerRequired):
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+	case errors.Is(err, word.ErrSelfApproval):
+		NewHTTPError(w, err.Error(), http.StatusForbidden)
+	default:
+		NewHTTPError(w, "Failed to update word", http.StatusInternalServerError)
+	}
+}
+
+func (h *WordHandler) DeleteWordHandler(w http.ResponseWriter, r *http.Request) {
+	err := h.WordService.DeleteWord(chi.URLParam(r, "id"), editorOf(r), time.Now())
+	if errors.Is(err, word.ErrWordNotFound) {
+		NewHTTPError(w, "Word not found", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		NewHTTPError(w, "Failed to delete word", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *WordHandler) GetWordsHandler(w http.ResponseWriter, r *http.Request) {
 	q := r.URL.Query()
 
@@ -64,21 +275,53 @@ func (h *WordHandler) GetWordsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	levels, err := word.ParseLevels(splitQueryList(q.Get("level")))
+	if err != nil {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	statuses, err := word.ParseStatuses(splitQueryList(q.Get("status")))
+	if err != nil {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	withTotal := false
+	if value := q.Get("with_total"); value != "" {
+		if withTotal, err = strconv.ParseBool(value); err != nil {
+			NewHTTPError(w, "Invalid query parameters", http.StatusBadRequest)
+			return
+		}
+	}
+
 	params := &word.SearchParams{
 		Page:        page,
 		PageSize:    pageSize,
 		SortBy:      sortBy,
 		SortOrder:   sortOrder,
+		Sort:        q.Get("sort"),
 		IsDelivered: &isDelivered,
+		Levels:      levels,
+		Tags:        word.NormalizeTags(splitQueryList(q.Get("tag"))),
+		Statuses:    statuses,
+		UseCursor:   q.Has("cursor"), // even empty, for the first page
+		Cursor:      q.Get("cursor"),
+		WithTotal:   withTotal,
 	}
 
 	words, err := h.WordService.FindWords(params)
+	if errors.Is(err, word.ErrInvalidCursor) || errors.Is(err, word.ErrInvalidSort) {
+		NewHTTPError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	if err != nil {
 		NewHTTPError(w, "Failed to retrieve words", http.StatusInternalServerError)
 		return
 	}
 
-	if page > words.LastPage {
+	// Cursor pages carry no page numbers, so a page passed along with a cursor is ignored.
+	if !params.UseCursor && page > words.LastPage {
 		NewHTTPError(w, "Page not found", http.StatusNotFound)
 		return
 	}
@@ -89,3 +332,11 @@ func (h *WordHandler) GetWordsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// splitQueryList splits a comma-separated query value such as "B1,B2".
+func splitQueryList(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
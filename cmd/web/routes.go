@@ -2,27 +2,140 @@ package web
 
 import (
 	"net/http"
+	"os"
 
 	"github.com/Go-roro/wordrop/cmd/web/handlers"
+	"github.com/Go-roro/wordrop/internal/delivery"
+	"github.com/Go-roro/wordrop/internal/dictionary"
+	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/media"
+	"github.com/Go-roro/wordrop/internal/quiz"
+	"github.com/Go-roro/wordrop/internal/review"
+	"github.com/Go-roro/wordrop/internal/schedule"
 	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/tracking"
 	"github.com/Go-roro/wordrop/internal/word"
 	"github.com/go-chi/chi/v5"
 )
 
-func SetupRouter(wordService *word.Service, subscriptionService *subscription.Service) http.Handler {
+func SetupRouter(
+	wordService *word.Service,
+	subscriptionService *subscription.Service,
+	trackingService *tracking.Service,
+	deliveryService *delivery.Service,
+	reviewService *review.Service,
+	quizService *quiz.Service,
+	scheduleService *schedule.Service,
+	dictionaryService *dictionary.Service,
+	mediaService *media.Service,
+	mailSender *email.GmailSender,
+) http.Handler {
 	r := chi.NewRouter()
 	wordHandler := &handlers.WordHandler{WordService: wordService}
 	subscriptionHandler := &handlers.SubscriptionHandler{SubscriptionService: subscriptionService}
+	bounceHandler := &handlers.BounceHandler{
+		SubscriptionService: subscriptionService,
+		WebhookToken:        os.Getenv("BOUNCE_WEBHOOK_TOKEN"),
+	}
+	trackingHandler := &handlers.TrackingHandler{TrackingService: trackingService}
+	emailHandler := &handlers.EmailHandler{MailSender: mailSender, WordService: wordService}
+	manageHandler := handlers.NewManageHandler(subscriptionService)
+	deliveryHandler := &handlers.DeliveryHandler{DeliveryService: deliveryService}
+	reviewHandler := &handlers.ReviewHandler{ReviewService: reviewService}
+	quizHandler := &handlers.QuizHandler{QuizService: quizService}
+	publicHandler := &handlers.PublicHandler{WordService: wordService}
+	feedHandler := &handlers.FeedHandler{WordService: wordService, ScheduleService: scheduleService}
+	scheduleHandler := &handlers.ScheduleHandler{ScheduleService: scheduleService}
+	dictionaryHandler := &handlers.DictionaryHandler{DictionaryService: dictionaryService}
+	mediaHandler := &handlers.MediaHandler{MediaService: mediaService}
 
 	r.Route("/words", func(r chi.Router) {
 		r.Post("/", wordHandler.SaveWordHandler)
-		r.Put("/", wordHandler.UpdateWordHandler)
 		r.Get("/", wordHandler.GetWordsHandler)
+		r.Get("/{id}", wordHandler.GetWordHandler)
+		r.Get("/{id}/enrichment", dictionaryHandler.ProposeEnrichment)
+		r.Get("/{id}/relations", wordHandler.GetRelatedWordsHandler)
+		r.Get("/{id}/media", mediaHandler.GetMedia)
+
+		// Changing a word that may already be approved or scheduled takes the same token as approving it,
+		// so its content cannot be rewritten after review.
+		r.Group(func(r chi.Router) {
+			r.Use(handlers.AdminOnly(os.Getenv("ADMIN_API_TOKEN")))
+			r.Put("/", wordHandler.UpdateWordHandler)
+			r.Patch("/{id}", wordHandler.PatchWordHandler)
+			r.Post("/{id}/relations", wordHandler.RelateWordHandler)
+			r.Delete("/{id}/relations/{relationID}", wordHandler.UnrelateWordHandler)
+			r.Post("/{id}/media", mediaHandler.UploadMedia)
+			r.Delete("/{id}/media/{attachmentID}", mediaHandler.DeleteMedia)
+			r.Delete("/{id}", wordHandler.DeleteWordHandler)
+		})
+	})
+
+	r.Route("/public", func(r chi.Router) {
+		r.Get("/today", publicHandler.GetToday)
+		r.Get("/words/{text}", publicHandler.GetWord)
+		r.Get("/archive", publicHandler.GetArchive)
 	})
 
+	r.Get("/media/*", mediaHandler.ServeFile)
+
+	r.Get("/feed.rss", feedHandler.GetRSS)
+	r.Get("/feed.atom", feedHandler.GetAtom)
+	r.Get("/feed.ics", feedHandler.GetCalendar)
+
 	r.Route("/subscriptions", func(r chi.Router) {
 		r.Post("/", subscriptionHandler.SaveNewSubscription)
 		r.Get("/verify", subscriptionHandler.VerifySubscription)
+		r.Get("/preferences", subscriptionHandler.GetPreferences)
+		r.Put("/preferences", subscriptionHandler.UpdatePreferences)
+	})
+
+	r.Route("/manage", func(r chi.Router) {
+		r.Get("/", manageHandler.Overview)
+		r.Post("/link", manageHandler.RequestLink)
+		r.Get("/login", manageHandler.Login)
+		r.Get("/profile", manageHandler.ProfileForm)
+		r.Post("/profile", manageHandler.UpdateProfile)
+		r.Get("/preferences", manageHandler.PreferencesForm)
+		r.Post("/preferences", manageHandler.UpdatePreferences)
+		r.Get("/unsubscribe", manageHandler.UnsubscribeForm)
+		r.Post("/unsubscribe", manageHandler.Unsubscribe)
+	})
+
+	r.Route("/webhooks/bounces", func(r chi.Router) {
+		r.Post("/", bounceHandler.ReceiveNotifications)
+		r.Post("/sns", bounceHandler.ReceiveSNSNotifications)
+	})
+
+	r.Route("/tracking", func(r chi.Router) {
+		r.Get("/open/{token}", trackingHandler.TrackOpen)
+		r.Get("/click/{token}", trackingHandler.TrackClick)
+		r.Get("/opt-out/{token}", trackingHandler.OptOutForm)
+		r.Post("/opt-out/{token}", trackingHandler.OptOut)
+	})
+
+	r.Get("/reviews/answer/{token}", reviewHandler.AnswerReview)
+	r.Get("/quizzes/answer/{token}", quizHandler.AnswerQuiz)
+
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(handlers.AdminOnly(os.Getenv("ADMIN_API_TOKEN")))
+		r.Get("/emails/preview/{template}", emailHandler.PreviewEmail)
+		r.Get("/subscriptions/{id}/deliveries", deliveryHandler.GetSubscriptionDeliveries)
+		r.Post("/subscriptions/{id}/resume", subscriptionHandler.ResumeDelivery)
+		r.Get("/tracking/words/{id}", trackingHandler.GetWordSummary)
+		r.Get("/tracking/subscriptions/{id}", trackingHandler.GetSubscriptionSummary)
+
+		r.Post("/words/{id}/status", wordHandler.ChangeStatusHandler)
+		r.Post("/words/{id}/comments", wordHandler.AddCommentHandler)
+		r.Get("/words/{id}/revisions", wordHandler.GetRevisionsHandler)
+		r.Get("/words/{id}/revisions/diff", wordHandler.DiffRevisionsHandler)
+		r.Post("/words/{id}/revisions/{number}/restore", wordHandler.RestoreRevisionHandler)
+
+		r.Get("/schedule", scheduleHandler.GetUpcoming)
+		r.Put("/schedule/order", scheduleHandler.Reorder)
+		r.Post("/schedule/backfill", scheduleHandler.Backfill)
+		r.Put("/schedule/{date}", scheduleHandler.Assign)
+		r.Delete("/schedule/{date}", scheduleHandler.Unassign)
 	})
 	return r
 }
@@ -34,30 +34,254 @@ func (p *JwtProvider) GenerateVerificationToken(id string, verificationCode stri
 		},
 	}
 
+	return p.signToken(claims)
+}
+
+func (p *JwtProvider) ParseVerificationToken(tokenString string) (*VerificationTokenClaims, error) {
+	claims := &VerificationTokenClaims{}
+	if err := p.parseToken(tokenString, claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// TrackingTokenClaims identifies the subscription and word behind an open or click.
+// URL is only set for click tokens and is the redirect target.
+type TrackingTokenClaims struct {
+	SubscriptionID string `json:"sid"`
+	WordID         string `json:"wid,omitempty"`
+	URL            string `json:"url,omitempty"`
+	jwt.RegisteredClaims
+}
+
+const (
+	// Tracking links live in the subscriber's mailbox, so they stay valid much longer than verification links.
+	trackingTokenTTL      = 365 * 24 * time.Hour
+	trackingTokenAudience = "tracking"
+)
+
+func (p *JwtProvider) GenerateTrackingToken(subscriptionID, wordID, url string) (string, error) {
+	claims := &TrackingTokenClaims{
+		SubscriptionID: subscriptionID,
+		WordID:         wordID,
+		URL:            url,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{trackingTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(trackingTokenTTL)),
+		},
+	}
+	return p.signToken(claims)
+}
+
+func (p *JwtProvider) ParseTrackingToken(tokenString string) (*TrackingTokenClaims, error) {
+	claims := &TrackingTokenClaims{}
+	if err := p.parseToken(tokenString, claims, jwt.WithAudience(trackingTokenAudience)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// SubscriberTokenClaims identifies a subscriber in self-service links.
+type SubscriberTokenClaims struct {
+	SubscriptionID string `json:"sid"`
+	jwt.RegisteredClaims
+}
+
+const (
+	preferencesTokenTTL      = 365 * 24 * time.Hour
+	preferencesTokenAudience = "preferences"
+)
+
+// GeneratePreferencesToken signs the link in every daily email that lets a subscriber edit their delivery preferences.
+func (p *JwtProvider) GeneratePreferencesToken(subscriptionID string) (string, error) {
+	claims := &SubscriberTokenClaims{
+		SubscriptionID: subscriptionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{preferencesTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(preferencesTokenTTL)),
+		},
+	}
+	return p.signToken(claims)
+}
+
+func (p *JwtProvider) ParsePreferencesToken(tokenString string) (*SubscriberTokenClaims, error) {
+	claims := &SubscriberTokenClaims{}
+	if err := p.parseToken(tokenString, claims, jwt.WithAudience(preferencesTokenAudience)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+const (
+	// Manage links are emailed on request and only exchanged once for a manage session.
+	manageTokenTTL             = 30 * time.Minute
+	manageTokenAudience        = "manage"
+	manageSessionTokenTTL      = time.Hour
+	manageSessionTokenAudience = "manage-session"
+)
+
+// GenerateManageToken signs a "manage my subscription" magic link. The nonce is stored on the
+// subscription and cleared when the link is used, which makes the link single use.
+func (p *JwtProvider) GenerateManageToken(subscriptionID, nonce string) (string, error) {
+	claims := &SubscriberTokenClaims{
+		SubscriptionID: subscriptionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        nonce,
+			Audience:  jwt.ClaimStrings{manageTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(manageTokenTTL)),
+		},
+	}
+	return p.signToken(claims)
+}
+
+func (p *JwtProvider) ParseManageToken(tokenString string) (*SubscriberTokenClaims, error) {
+	claims := &SubscriberTokenClaims{}
+	if err := p.parseToken(tokenString, claims, jwt.WithAudience(manageTokenAudience)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// GenerateManageSessionToken signs the session cookie handed out in exchange for a manage link.
+func (p *JwtProvider) GenerateManageSessionToken(subscriptionID string) (string, error) {
+	claims := &SubscriberTokenClaims{
+		SubscriptionID: subscriptionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{manageSessionTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(manageSessionTokenTTL)),
+		},
+	}
+	return p.signToken(claims)
+}
+
+func (p *JwtProvider) ParseManageSessionToken(tokenString string) (*SubscriberTokenClaims, error) {
+	claims := &SubscriberTokenClaims{}
+	if err := p.parseToken(tokenString, claims, jwt.WithAudience(manageSessionTokenAudience)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// ReviewTokenClaims carries a subscriber's answer to a spaced-repetition review link.
+type ReviewTokenClaims struct {
+	SubscriptionID string `json:"sid"`
+	ReviewID       string `json:"rid"`
+	Remembered     bool   `json:"remembered"`
+	jwt.RegisteredClaims
+}
+
+const (
+	reviewTokenTTL      = 30 * 24 * time.Hour
+	reviewTokenAudience = "review"
+)
+
+func (p *JwtProvider) GenerateReviewToken(subscriptionID, reviewID string, remembered bool) (string, error) {
+	claims := &ReviewTokenClaims{
+		SubscriptionID: subscriptionID,
+		ReviewID:       reviewID,
+		Remembered:     remembered,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{reviewTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(reviewTokenTTL)),
+		},
+	}
+	return p.signToken(claims)
+}
+
+func (p *JwtProvider) ParseReviewToken(tokenString string) (*ReviewTokenClaims, error) {
+	claims := &ReviewTokenClaims{}
+	if err := p.parseToken(tokenString, claims, jwt.WithAudience(reviewTokenAudience)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// QuizTokenClaims carries the choice a subscriber picked for one question of a weekly quiz.
+type QuizTokenClaims struct {
+	SubscriptionID string `json:"sid"`
+	QuizID         string `json:"qid"`
+	Question       int    `json:"q"`
+	Choice         int    `json:"c"`
+	jwt.RegisteredClaims
+}
+
+const (
+	quizTokenTTL      = 14 * 24 * time.Hour
+	quizTokenAudience = "quiz"
+)
+
+func (p *JwtProvider) GenerateQuizToken(subscriptionID, quizID string, question, choice int) (string, error) {
+	claims := &QuizTokenClaims{
+		SubscriptionID: subscriptionID,
+		QuizID:         quizID,
+		Question:       question,
+		Choice:         choice,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{quizTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(quizTokenTTL)),
+		},
+	}
+	return p.signToken(claims)
+}
+
+func (p *JwtProvider) ParseQuizToken(tokenString string) (*QuizTokenClaims, error) {
+	claims := &QuizTokenClaims{}
+	if err := p.parseToken(tokenString, claims, jwt.WithAudience(quizTokenAudience)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// MediaTokenClaims grants access to one file in local media storage until the token expires.
+type MediaTokenClaims struct {
+	Key string `json:"key"`
+	jwt.RegisteredClaims
+}
+
+const mediaTokenAudience = "media"
+
+func (p *JwtProvider) GenerateMediaToken(key string, expiresAt time.Time) (string, error) {
+	claims := &MediaTokenClaims{
+		Key: key,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Audience:  jwt.ClaimStrings{mediaTokenAudience},
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	return p.signToken(claims)
+}
+
+func (p *JwtProvider) ParseMediaToken(tokenString string) (*MediaTokenClaims, error) {
+	claims := &MediaTokenClaims{}
+	if err := p.parseToken(tokenString, claims, jwt.WithAudience(mediaTokenAudience)); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (p *JwtProvider) signToken(claims jwt.Claims) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	tokenString, err := token.SignedString(p.secretKey)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
-
 	return tokenString, nil
 }
 
-func (p *JwtProvider) ParseVerificationToken(tokenString string) (*VerificationTokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &VerificationTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
+func (p *JwtProvider) parseToken(tokenString string, claims jwt.Claims, options ...jwt.ParserOption) error {
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 		return p.secretKey, nil
-	})
+	}, options...)
 
 	if err != nil {
-		return nil, fmt.Errorf("invalid token: %w", err)
+		return fmt.Errorf("invalid token: %w", err)
 	}
 
-	if claims, ok := token.Claims.(*VerificationTokenClaims); ok && token.Valid {
-		return claims, nil
+	if !token.Valid {
+		return fmt.Errorf("invalid token: unable to parse claims")
 	}
-
-	return nil, fmt.Errorf("invalid token: unable to parse claims")
+	return nil
 }
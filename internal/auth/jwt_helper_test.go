@@ -58,6 +58,172 @@ func TestJwtProvider_ParseVerificationToken(t *testing.T) {
 	})
 }
 
+func TestJwtProvider_TrackingToken(t *testing.T) {
+	provider, err := NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	require.NoError(t, err)
+
+	t.Run("Round Trip", func(t *testing.T) {
+		token, err := provider.GenerateTrackingToken("sub-1", "word-1", "https://example.com/a?b=c")
+		require.NoError(t, err)
+
+		claims, err := provider.ParseTrackingToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "sub-1", claims.SubscriptionID)
+		assert.Equal(t, "word-1", claims.WordID)
+		assert.Equal(t, "https://example.com/a?b=c", claims.URL)
+	})
+
+	t.Run("Failure-Invalid Signature", func(t *testing.T) {
+		otherProvider, _ := NewJwtProvider("different-secret")
+		token, err := otherProvider.GenerateTrackingToken("sub-1", "word-1", "https://evil.example.com")
+		require.NoError(t, err)
+
+		_, err = provider.ParseTrackingToken(token)
+		assert.True(t, errors.Is(err, jwt.ErrTokenSignatureInvalid))
+	})
+
+	t.Run("Failure-Verification Token", func(t *testing.T) {
+		token, err := provider.GenerateVerificationToken("sub-1", "code")
+		require.NoError(t, err)
+
+		_, err = provider.ParseTrackingToken(token)
+		assert.Error(t, err, "Expected verification token to be rejected as tracking token")
+	})
+}
+
+func TestJwtProvider_PreferencesToken(t *testing.T) {
+	provider, err := NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	require.NoError(t, err)
+
+	t.Run("Round Trip", func(t *testing.T) {
+		token, err := provider.GeneratePreferencesToken("sub-1")
+		require.NoError(t, err)
+
+		claims, err := provider.ParsePreferencesToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "sub-1", claims.SubscriptionID)
+	})
+
+	t.Run("Failure-Tracking Token", func(t *testing.T) {
+		token, err := provider.GenerateTrackingToken("sub-1", "", "")
+		require.NoError(t, err)
+
+		_, err = provider.ParsePreferencesToken(token)
+		assert.True(t, errors.Is(err, jwt.ErrTokenInvalidAudience))
+	})
+}
+
+func TestJwtProvider_ManageToken(t *testing.T) {
+	provider, err := NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	require.NoError(t, err)
+
+	t.Run("Round Trip", func(t *testing.T) {
+		token, err := provider.GenerateManageToken("sub-1", "nonce-1")
+		require.NoError(t, err)
+
+		claims, err := provider.ParseManageToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "sub-1", claims.SubscriptionID)
+		assert.Equal(t, "nonce-1", claims.ID)
+		assert.WithinDuration(t, time.Now().Add(manageTokenTTL), claims.ExpiresAt.Time, time.Minute)
+	})
+
+	t.Run("Failure-Session Token", func(t *testing.T) {
+		token, err := provider.GenerateManageSessionToken("sub-1")
+		require.NoError(t, err)
+
+		_, err = provider.ParseManageToken(token)
+		assert.True(t, errors.Is(err, jwt.ErrTokenInvalidAudience))
+	})
+
+	t.Run("Failure-Preferences Token As Session", func(t *testing.T) {
+		token, err := provider.GeneratePreferencesToken("sub-1")
+		require.NoError(t, err)
+
+		_, err = provider.ParseManageSessionToken(token)
+		assert.True(t, errors.Is(err, jwt.ErrTokenInvalidAudience))
+	})
+}
+
+func TestJwtProvider_ReviewToken(t *testing.T) {
+	provider, err := NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	require.NoError(t, err)
+
+	t.Run("Round Trip", func(t *testing.T) {
+		token, err := provider.GenerateReviewToken("sub-1", "review-1", true)
+		require.NoError(t, err)
+
+		claims, err := provider.ParseReviewToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "sub-1", claims.SubscriptionID)
+		assert.Equal(t, "review-1", claims.ReviewID)
+		assert.True(t, claims.Remembered)
+	})
+
+	t.Run("Failure-Tracking Token", func(t *testing.T) {
+		token, err := provider.GenerateTrackingToken("sub-1", "", "")
+		require.NoError(t, err)
+
+		_, err = provider.ParseReviewToken(token)
+		assert.True(t, errors.Is(err, jwt.ErrTokenInvalidAudience))
+	})
+}
+
+func TestJwtProvider_QuizToken(t *testing.T) {
+	provider, err := NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	require.NoError(t, err)
+
+	t.Run("Round Trip", func(t *testing.T) {
+		token, err := provider.GenerateQuizToken("sub-1", "quiz-1", 2, 3)
+		require.NoError(t, err)
+
+		claims, err := provider.ParseQuizToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "sub-1", claims.SubscriptionID)
+		assert.Equal(t, "quiz-1", claims.QuizID)
+		assert.Equal(t, 2, claims.Question)
+		assert.Equal(t, 3, claims.Choice)
+	})
+
+	t.Run("Failure-Review Token", func(t *testing.T) {
+		token, err := provider.GenerateReviewToken("sub-1", "review-1", true)
+		require.NoError(t, err)
+
+		_, err = provider.ParseQuizToken(token)
+		assert.True(t, errors.Is(err, jwt.ErrTokenInvalidAudience))
+	})
+}
+
+func TestJwtProvider_MediaToken(t *testing.T) {
+	provider, err := NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	require.NoError(t, err)
+
+	t.Run("Round Trip", func(t *testing.T) {
+		token, err := provider.GenerateMediaToken("words/1/audio.mp3", time.Now().Add(time.Minute))
+		require.NoError(t, err)
+
+		claims, err := provider.ParseMediaToken(token)
+		require.NoError(t, err)
+		assert.Equal(t, "words/1/audio.mp3", claims.Key)
+	})
+
+	t.Run("Failure-Expired", func(t *testing.T) {
+		token, err := provider.GenerateMediaToken("words/1/audio.mp3", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		_, err = provider.ParseMediaToken(token)
+		assert.True(t, errors.Is(err, jwt.ErrTokenExpired))
+	})
+
+	t.Run("Failure-Quiz Token", func(t *testing.T) {
+		token, err := provider.GenerateQuizToken("sub-1", "quiz-1", 2, 3)
+		require.NoError(t, err)
+
+		_, err = provider.ParseMediaToken(token)
+		assert.True(t, errors.Is(err, jwt.ErrTokenInvalidAudience))
+	})
+}
+
 func generateExpiredToken(secret string) string {
 	expirationTime := time.Now()
 	claims := &VerificationTokenClaims{
@@ -0,0 +1,176 @@
+package bounce
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+)
+
+// ErrNotReport is returned by ParseDSN for messages that are neither an RFC 3464
+// delivery status notification nor an RFC 5965 feedback report.
+var ErrNotReport = errors.New("message is not a delivery status or feedback report")
+
+// ParseDSN reads a single RFC 5322 message and extracts the failed recipients of a
+// delivery status notification, or the complaining recipient of an ARF feedback report.
+func ParseDSN(r io.Reader) ([]Notification, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid message: %w", err)
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/report" {
+		return nil, ErrNotReport
+	}
+
+	reportType := strings.ToLower(params["report-type"])
+	if reportType != "delivery-status" && reportType != "feedback-report" {
+		return nil, ErrNotReport
+	}
+
+	var notifications []Notification
+	var feedback textproto.MIMEHeader
+	var originalHeader textproto.MIMEHeader
+
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid report part: %w", err)
+		}
+
+		partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+		body := decodePart(part)
+		switch strings.ToLower(partType) {
+		case "message/delivery-status", "message/global-delivery-status":
+			statuses, err := parseDeliveryStatus(body)
+			if err != nil {
+				return nil, err
+			}
+			notifications = append(notifications, statuses...)
+		case "message/feedback-report":
+			if feedback, err = readFields(body); err != nil {
+				return nil, err
+			}
+		case "message/rfc822", "text/rfc822-headers":
+			if originalHeader, err = readFields(body); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if reportType == "feedback-report" {
+		return parseFeedbackReport(feedback, originalHeader)
+	}
+	return notifications, nil
+}
+
+func decodePart(part *multipart.Part) io.Reader {
+	if strings.EqualFold(part.Header.Get("Content-Transfer-Encoding"), "base64") {
+		return base64.NewDecoder(base64.StdEncoding, part)
+	}
+	return part
+}
+
+func readFields(r io.Reader) (textproto.MIMEHeader, error) {
+	fields, err := textproto.NewReader(bufio.NewReader(r)).ReadMIMEHeader()
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("invalid report fields: %w", err)
+	}
+	return fields, nil
+}
+
+// parseDeliveryStatus reads the per-message field block followed by one field block per recipient.
+func parseDeliveryStatus(r io.Reader) ([]Notification, error) {
+	reader := textproto.NewReader(bufio.NewReader(r))
+	if _, err := reader.ReadMIMEHeader(); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("invalid delivery status: %w", err)
+	}
+
+	var notifications []Notification
+	for {
+		fields, err := reader.ReadMIMEHeader()
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("invalid delivery status: %w", err)
+		}
+
+		if notification, ok := recipientNotification(fields); ok {
+			notifications = append(notifications, notification)
+		}
+		if err == io.EOF {
+			return notifications, nil
+		}
+	}
+}
+
+func recipientNotification(fields textproto.MIMEHeader) (Notification, bool) {
+	action := strings.ToLower(strings.TrimSpace(fields.Get("Action")))
+	if action != "failed" && action != "delayed" {
+		return Notification{}, false
+	}
+
+	recipient := addressField(fields.Get("Final-Recipient"))
+	if recipient == "" {
+		recipient = addressField(fields.Get("Original-Recipient"))
+	}
+	if recipient == "" {
+		return Notification{}, false
+	}
+
+	status := strings.TrimSpace(fields.Get("Status"))
+	reason := addressField(fields.Get("Diagnostic-Code"))
+	if reason == "" {
+		reason = status
+	}
+
+	return Notification{
+		Email:     recipient,
+		Kind:      KindBounce,
+		Permanent: action == "failed" && strings.HasPrefix(status, "5"),
+		Reason:    reason,
+	}, true
+}
+
+func parseFeedbackReport(feedback, originalHeader textproto.MIMEHeader) ([]Notification, error) {
+	if feedback == nil {
+		return nil, errors.New("feedback report without message/feedback-report part")
+	}
+
+	recipient := feedback.Get("Original-Rcpt-To")
+	if recipient == "" && originalHeader != nil {
+		if address, err := mail.ParseAddress(originalHeader.Get("To")); err == nil {
+			recipient = address.Address
+		}
+	}
+	if recipient == "" {
+		return nil, errors.New("feedback report without recipient")
+	}
+
+	return []Notification{{
+		Email:     strings.TrimSpace(recipient),
+		Kind:      KindComplaint,
+		Permanent: true,
+		Reason:    strings.TrimSpace(feedback.Get("Feedback-Type")),
+	}}, nil
+}
+
+// addressField strips the type prefix from typed fields such as "rfc822; user@example.com".
+func addressField(value string) string {
+	if _, rest, ok := strings.Cut(value, ";"); ok {
+		value = rest
+	}
+	return strings.TrimSpace(value)
+}
@@ -0,0 +1,125 @@
+package bounce
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const dsnFixture = "From: Mail Delivery System <MAILER-DAEMON@mx.example.com>\r\n" +
+	"To: noreply@wordrop.com\r\n" +
+	"Subject: Undelivered Mail Returned to Sender\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/report; report-type=delivery-status; boundary=\"BOUNDARY\"\r\n" +
+	"\r\n" +
+	"--BOUNDARY\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"\r\n" +
+	"I'm sorry to have to inform you that your message could not be delivered.\r\n" +
+	"--BOUNDARY\r\n" +
+	"Content-Type: message/delivery-status\r\n" +
+	"\r\n" +
+	"Reporting-MTA: dns; mx.example.com\r\n" +
+	"Arrival-Date: Mon, 19 Oct 2026 09:00:00 +0900\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; gone@example.com\r\n" +
+	"Action: failed\r\n" +
+	"Status: 5.1.1\r\n" +
+	"Diagnostic-Code: smtp; 550 5.1.1 user unknown\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; full@example.com\r\n" +
+	"Action: delayed\r\n" +
+	"Status: 4.2.2\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; fine@example.com\r\n" +
+	"Action: delivered\r\n" +
+	"Status: 2.0.0\r\n" +
+	"\r\n" +
+	"--BOUNDARY\r\n" +
+	"Content-Type: text/rfc822-headers\r\n" +
+	"\r\n" +
+	"To: gone@example.com\r\n" +
+	"Subject: Wordrop - 오늘의 단어\r\n" +
+	"--BOUNDARY--\r\n"
+
+const arfFixture = "From: feedback@isp.example.com\r\n" +
+	"To: abuse@wordrop.com\r\n" +
+	"Subject: FW: Wordrop\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/report; report-type=feedback-report; boundary=\"ARF\"\r\n" +
+	"\r\n" +
+	"--ARF\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"\r\n" +
+	"This is an email abuse report.\r\n" +
+	"--ARF\r\n" +
+	"Content-Type: message/feedback-report\r\n" +
+	"\r\n" +
+	"Feedback-Type: abuse\r\n" +
+	"User-Agent: SomeGenerator/1.0\r\n" +
+	"Version: 1\r\n" +
+	"\r\n" +
+	"--ARF\r\n" +
+	"Content-Type: message/rfc822\r\n" +
+	"\r\n" +
+	"From: noreply@wordrop.com\r\n" +
+	"To: Angry User <angry@example.com>\r\n" +
+	"Subject: Wordrop - 오늘의 단어\r\n" +
+	"\r\n" +
+	"body\r\n" +
+	"--ARF--\r\n"
+
+func TestParseDSN_DeliveryStatus(t *testing.T) {
+	notifications, err := ParseDSN(strings.NewReader(dsnFixture))
+	require.NoError(t, err)
+	require.Len(t, notifications, 2, "Delivered recipients should be ignored")
+
+	assert.Equal(t, Notification{
+		Email:     "gone@example.com",
+		Kind:      KindBounce,
+		Permanent: true,
+		Reason:    "550 5.1.1 user unknown",
+	}, notifications[0])
+	assert.Equal(t, "full@example.com", notifications[1].Email)
+	assert.False(t, notifications[1].Permanent, "Delayed delivery should be a soft bounce")
+}
+
+func TestParseDSN_FeedbackReport(t *testing.T) {
+	notifications, err := ParseDSN(strings.NewReader(arfFixture))
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+
+	assert.Equal(t, "angry@example.com", notifications[0].Email)
+	assert.Equal(t, KindComplaint, notifications[0].Kind)
+	assert.Equal(t, "abuse", notifications[0].Reason)
+}
+
+func TestParseDSN_NotReport(t *testing.T) {
+	message := "From: someone@example.com\r\nTo: noreply@wordrop.com\r\nSubject: hi\r\n\r\nhello\r\n"
+	_, err := ParseDSN(strings.NewReader(message))
+	assert.ErrorIs(t, err, ErrNotReport)
+}
+
+func TestParseMbox(t *testing.T) {
+	mbox := "From MAILER-DAEMON Mon Oct 19 09:00:00 2026\n" +
+		strings.ReplaceAll(dsnFixture, "\r\n", "\n") +
+		"\n" +
+		"From someone@example.com Mon Oct 19 09:05:00 2026\n" +
+		"From: someone@example.com\n" +
+		"Subject: not a report\n" +
+		"\n" +
+		">From the desk of someone\n" +
+		"\n" +
+		"From feedback@isp.example.com Mon Oct 19 09:10:00 2026\n" +
+		strings.ReplaceAll(arfFixture, "\r\n", "\n")
+
+	notifications, err := ParseMbox(strings.NewReader(mbox))
+	require.NoError(t, err)
+	require.Len(t, notifications, 3)
+
+	assert.Equal(t, "gone@example.com", notifications[0].Email)
+	assert.Equal(t, "full@example.com", notifications[1].Email)
+	assert.Equal(t, "angry@example.com", notifications[2].Email)
+}
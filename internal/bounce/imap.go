@@ -0,0 +1,116 @@
+package bounce
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/emersion/go-imap"
+	"github.com/emersion/go-imap/client"
+)
+
+const (
+	imapAddressEnv  = "IMAP_ADDRESS"
+	imapUsernameEnv = "IMAP_USERNAME"
+	imapPasswordEnv = "IMAP_PASSWORD"
+	imapMailboxEnv  = "IMAP_MAILBOX"
+
+	defaultImapMailbox = "INBOX"
+)
+
+type ImapConfig struct {
+	address  string
+	username string
+	password string
+	mailbox  string
+}
+
+func NewImapConfig() (*ImapConfig, error) {
+	for _, envKey := range []string{imapAddressEnv, imapUsernameEnv, imapPasswordEnv} {
+		if value, ok := os.LookupEnv(envKey); value == "" || !ok {
+			return nil, fmt.Errorf("environment variable %s is not set", envKey)
+		}
+	}
+
+	mailbox := defaultImapMailbox
+	if value, ok := os.LookupEnv(imapMailboxEnv); ok && value != "" {
+		mailbox = value
+	}
+
+	return &ImapConfig{
+		address:  os.Getenv(imapAddressEnv),
+		username: os.Getenv(imapUsernameEnv),
+		password: os.Getenv(imapPasswordEnv),
+		mailbox:  mailbox,
+	}, nil
+}
+
+// ProcessImap reads the unseen messages of the configured bounce mailbox, applies the
+// reports found in them and marks the messages as seen only once they were applied.
+func ProcessImap(config *ImapConfig, suspender Suspender) (int, error) {
+	c, err := client.DialTLS(config.address, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to connect to IMAP server: %w", err)
+	}
+	defer func() {
+		if err := c.Logout(); err != nil {
+			log.Printf("Failed to log out from IMAP server: %v", err)
+		}
+	}()
+
+	if err := c.Login(config.username, config.password); err != nil {
+		return 0, fmt.Errorf("failed to log in to IMAP server: %w", err)
+	}
+
+	if _, err := c.Select(config.mailbox, false); err != nil {
+		return 0, fmt.Errorf("failed to select mailbox %s: %w", config.mailbox, err)
+	}
+
+	criteria := imap.NewSearchCriteria()
+	criteria.WithoutFlags = []string{imap.SeenFlag}
+	seqNums, err := c.Search(criteria)
+	if err != nil {
+		return 0, fmt.Errorf("failed to search unseen messages: %w", err)
+	}
+	if len(seqNums) == 0 {
+		return 0, nil
+	}
+
+	seqSet := new(imap.SeqSet)
+	seqSet.AddNum(seqNums...)
+	section := &imap.BodySectionName{Peek: true}
+
+	messages := make(chan *imap.Message, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- c.Fetch(seqSet, []imap.FetchItem{section.FetchItem()}, messages)
+	}()
+
+	var notifications []Notification
+	for msg := range messages {
+		body := msg.GetBody(section)
+		if body == nil {
+			continue
+		}
+		parsed, err := ParseDSN(body)
+		if err != nil && !errors.Is(err, ErrNotReport) {
+			log.Printf("Skipping unreadable report %d: %v", msg.SeqNum, err)
+		}
+		notifications = append(notifications, parsed...)
+	}
+	if err := <-done; err != nil {
+		return 0, fmt.Errorf("failed to fetch messages: %w", err)
+	}
+
+	applied, err := Apply(suspender, notifications)
+	if err != nil {
+		return applied, err
+	}
+
+	flags := []interface{}{imap.SeenFlag}
+	if err := c.Store(seqSet, imap.FormatFlagsOp(imap.AddFlags, true), flags, nil); err != nil {
+		return applied, fmt.Errorf("failed to mark messages as seen: %w", err)
+	}
+	return applied, nil
+}
@@ -0,0 +1,59 @@
+package bounce
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"strings"
+)
+
+// ParseMbox reads every message of an mbox(rd) file and collects the notifications
+// of those that are delivery status or feedback reports. Other messages are skipped.
+func ParseMbox(r io.Reader) ([]Notification, error) {
+	var notifications []Notification
+	var current bytes.Buffer
+	inMessage := false
+
+	flush := func() {
+		if !inMessage {
+			return
+		}
+		parsed, err := ParseDSN(bytes.NewReader(current.Bytes()))
+		if err != nil && !errors.Is(err, ErrNotReport) {
+			log.Printf("Skipping unreadable report in mbox: %v", err)
+		}
+		notifications = append(notifications, parsed...)
+		current.Reset()
+	}
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if strings.HasPrefix(line, "From ") {
+			flush()
+			inMessage = true
+		} else if inMessage {
+			current.WriteString(unescapeFromLine(line))
+		}
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	flush()
+	return notifications, nil
+}
+
+// unescapeFromLine reverses mboxrd quoting, where ">From " lines in a body gain one extra '>'.
+func unescapeFromLine(line string) string {
+	trimmed := strings.TrimLeft(line, ">")
+	if len(trimmed) < len(line) && strings.HasPrefix(trimmed, "From ") {
+		return line[1:]
+	}
+	return line
+}
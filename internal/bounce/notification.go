@@ -0,0 +1,63 @@
+package bounce
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/Go-roro/wordrop/internal/subscription"
+)
+
+type Kind string
+
+const (
+	KindBounce    Kind = "bounce"
+	KindComplaint Kind = "complaint"
+)
+
+// Notification is a single bounce or complaint for one recipient, normalized from
+// webhook payloads and DSN reports alike.
+type Notification struct {
+	Email     string `json:"email"`
+	Kind      Kind   `json:"type"`
+	Permanent bool   `json:"permanent"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+type Suspender interface {
+	MarkBounced(email, reason string) error
+	MarkComplained(email, reason string) error
+}
+
+// Apply suspends delivery for every hard bounce and complaint in notifications.
+// Soft bounces and unknown recipients are skipped; it returns how many subscriptions were suspended.
+func Apply(suspender Suspender, notifications []Notification) (int, error) {
+	applied := 0
+	for _, n := range notifications {
+		email := strings.TrimSpace(n.Email)
+		if email == "" {
+			continue
+		}
+
+		var err error
+		switch {
+		case n.Kind == KindComplaint:
+			err = suspender.MarkComplained(email, n.Reason)
+		case n.Kind == KindBounce && n.Permanent:
+			err = suspender.MarkBounced(email, n.Reason)
+		default:
+			log.Printf("Ignoring transient %s for %s: %s", n.Kind, email, n.Reason)
+			continue
+		}
+
+		if errors.Is(err, subscription.ErrSubscriptionNotFound) {
+			log.Printf("Ignoring %s for unknown recipient %s", n.Kind, email)
+			continue
+		}
+		if err != nil {
+			return applied, err
+		}
+		applied++
+	}
+	return applied, nil
+}
@@ -0,0 +1,51 @@
+package bounce
+
+import (
+	"testing"
+
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSuspender struct {
+	known      map[string]bool
+	bounced    []string
+	complained []string
+}
+
+func (f *fakeSuspender) MarkBounced(email, reason string) error {
+	if !f.known[email] {
+		return subscription.ErrSubscriptionNotFound
+	}
+	f.bounced = append(f.bounced, email)
+	return nil
+}
+
+func (f *fakeSuspender) MarkComplained(email, reason string) error {
+	if !f.known[email] {
+		return subscription.ErrSubscriptionNotFound
+	}
+	f.complained = append(f.complained, email)
+	return nil
+}
+
+func TestApply(t *testing.T) {
+	suspender := &fakeSuspender{known: map[string]bool{
+		"gone@example.com":  true,
+		"full@example.com":  true,
+		"angry@example.com": true,
+	}}
+
+	applied, err := Apply(suspender, []Notification{
+		{Email: "gone@example.com", Kind: KindBounce, Permanent: true, Reason: "550 user unknown"},
+		{Email: "full@example.com", Kind: KindBounce, Permanent: false, Reason: "452 mailbox full"},
+		{Email: "angry@example.com", Kind: KindComplaint, Reason: "abuse"},
+		{Email: "stranger@example.com", Kind: KindBounce, Permanent: true},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, applied)
+	assert.Equal(t, []string{"gone@example.com"}, suspender.bounced, "Soft bounces should not suspend delivery")
+	assert.Equal(t, []string{"angry@example.com"}, suspender.complained)
+}
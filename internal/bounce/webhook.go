@@ -0,0 +1,138 @@
+package bounce
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrSNSSubscriptionConfirmation is returned for the handshake SNS sends when a topic
+// subscription is created. It has to be confirmed manually through its SubscribeURL.
+var ErrSNSSubscriptionConfirmation = errors.New("sns subscription confirmation required")
+
+// ParseGeneric reads a single notification or a JSON array of notifications:
+//
+//	{"email": "user@example.com", "type": "bounce", "permanent": true, "reason": "550 5.1.1 user unknown"}
+func ParseGeneric(r io.Reader) ([]Notification, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] != '[' {
+		body = append(append([]byte{'['}, body...), ']')
+	}
+
+	var notifications []Notification
+	if err := json.Unmarshal(body, &notifications); err != nil {
+		return nil, fmt.Errorf("invalid notification payload: %w", err)
+	}
+
+	for _, n := range notifications {
+		if n.Email == "" {
+			return nil, errors.New("notification email is required")
+		}
+		if n.Kind != KindBounce && n.Kind != KindComplaint {
+			return nil, fmt.Errorf("unknown notification type %q", n.Kind)
+		}
+	}
+	return notifications, nil
+}
+
+type snsEnvelope struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+type sesEvent struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Bounce           *struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			Status         string `json:"status"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint *struct {
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+// ParseSNS reads an SES bounce or complaint event, either wrapped in an SNS
+// envelope or posted as the raw SES event.
+func ParseSNS(r io.Reader) ([]Notification, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var envelope snsEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid sns payload: %w", err)
+	}
+
+	switch envelope.Type {
+	case "SubscriptionConfirmation":
+		return nil, fmt.Errorf("%w: %s", ErrSNSSubscriptionConfirmation, envelope.SubscribeURL)
+	case "UnsubscribeConfirmation":
+		return nil, nil
+	case "Notification":
+		body = []byte(envelope.Message)
+	}
+
+	var event sesEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, fmt.Errorf("invalid ses event: %w", err)
+	}
+
+	eventType := event.NotificationType
+	if eventType == "" {
+		eventType = event.EventType
+	}
+
+	var notifications []Notification
+	switch eventType {
+	case "Bounce":
+		if event.Bounce == nil {
+			return nil, errors.New("ses bounce event without bounce object")
+		}
+		for _, recipient := range event.Bounce.BouncedRecipients {
+			reason := recipient.DiagnosticCode
+			if reason == "" {
+				reason = strings.TrimSpace(event.Bounce.BounceType + " " + event.Bounce.BounceSubType)
+			}
+			notifications = append(notifications, Notification{
+				Email:     recipient.EmailAddress,
+				Kind:      KindBounce,
+				Permanent: event.Bounce.BounceType == "Permanent",
+				Reason:    reason,
+			})
+		}
+	case "Complaint":
+		if event.Complaint == nil {
+			return nil, errors.New("ses complaint event without complaint object")
+		}
+		for _, recipient := range event.Complaint.ComplainedRecipients {
+			notifications = append(notifications, Notification{
+				Email:     recipient.EmailAddress,
+				Kind:      KindComplaint,
+				Permanent: true,
+				Reason:    event.Complaint.ComplaintFeedbackType,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported ses event type %q", eventType)
+	}
+	return notifications, nil
+}
@@ -0,0 +1,106 @@
+package bounce
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGeneric(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "Single Notification",
+			payload: `{"email": "gone@example.com", "type": "bounce", "permanent": true, "reason": "550 user unknown"}`,
+			want:    1,
+		},
+		{
+			name: "Notification Array",
+			payload: `[{"email": "gone@example.com", "type": "bounce", "permanent": true},
+				{"email": "angry@example.com", "type": "complaint"}]`,
+			want: 2,
+		},
+		{
+			name:    "Unknown Type",
+			payload: `{"email": "gone@example.com", "type": "open"}`,
+			wantErr: true,
+		},
+		{
+			name:    "Missing Email",
+			payload: `{"type": "bounce"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notifications, err := ParseGeneric(strings.NewReader(tt.payload))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, notifications, tt.want)
+		})
+	}
+}
+
+const sesBounceFixture = `{
+	"notificationType": "Bounce",
+	"bounce": {
+		"bounceType": "Permanent",
+		"bounceSubType": "General",
+		"bouncedRecipients": [
+			{"emailAddress": "gone@example.com", "status": "5.1.1", "diagnosticCode": "smtp; 550 5.1.1 user unknown"}
+		]
+	},
+	"mail": {"source": "noreply@wordrop.com"}
+}`
+
+func TestParseSNS(t *testing.T) {
+	t.Run("SNS Envelope", func(t *testing.T) {
+		envelope, _ := json.Marshal(map[string]string{"Type": "Notification", "Message": sesBounceFixture})
+
+		notifications, err := ParseSNS(strings.NewReader(string(envelope)))
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "gone@example.com", notifications[0].Email)
+		assert.Equal(t, KindBounce, notifications[0].Kind)
+		assert.True(t, notifications[0].Permanent)
+		assert.Equal(t, "smtp; 550 5.1.1 user unknown", notifications[0].Reason)
+	})
+
+	t.Run("Raw Complaint Event", func(t *testing.T) {
+		payload := `{"eventType": "Complaint", "complaint": {"complaintFeedbackType": "abuse",
+			"complainedRecipients": [{"emailAddress": "angry@example.com"}]}}`
+
+		notifications, err := ParseSNS(strings.NewReader(payload))
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, KindComplaint, notifications[0].Kind)
+		assert.Equal(t, "abuse", notifications[0].Reason)
+	})
+
+	t.Run("Transient Bounce", func(t *testing.T) {
+		payload := strings.Replace(sesBounceFixture, `"Permanent"`, `"Transient"`, 1)
+
+		notifications, err := ParseSNS(strings.NewReader(payload))
+		require.NoError(t, err)
+		require.Len(t, notifications, 1)
+		assert.False(t, notifications[0].Permanent)
+	})
+
+	t.Run("Subscription Confirmation", func(t *testing.T) {
+		payload := `{"Type": "SubscriptionConfirmation", "SubscribeURL": "https://sns.example.com/confirm"}`
+
+		_, err := ParseSNS(strings.NewReader(payload))
+		assert.ErrorIs(t, err, ErrSNSSubscriptionConfirmation)
+	})
+}
@@ -2,11 +2,15 @@ package common
 
 import "math"
 
+// PageResult is one page of a listing, either by page number or by cursor. Pages by number carry Page,
+// LastPage and TotalSize; pages by cursor carry NextCursor, and TotalSize only when it was asked for.
 type PageResult[T any] struct {
-	Data      []T   `json:"data"`
-	Page      int   `json:"page"`
-	LastPage  int   `json:"lastPage"`
-	TotalSize int64 `json:"totalSize"`
+	Data      []T    `json:"data"`
+	Page      int    `json:"page,omitempty"`
+	LastPage  int    `json:"lastPage,omitempty"`
+	TotalSize *int64 `json:"totalSize,omitempty"`
+	// NextCursor fetches the page after this one; it is empty on the last page.
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 func NewPageResult[T any](data []T, page int, pageSize int64, totalSize int64) *PageResult[T] {
@@ -20,6 +24,14 @@ func NewPageResult[T any](data []T, page int, pageSize int64, totalSize int64) *
 		Data:      data,
 		Page:      page,
 		LastPage:  lastPage,
-		TotalSize: totalSize,
+		TotalSize: &totalSize,
+	}
+}
+
+func NewCursorResult[T any](data []T, nextCursor string, totalSize *int64) *PageResult[T] {
+	return &PageResult[T]{
+		Data:       data,
+		TotalSize:  totalSize,
+		NextCursor: nextCursor,
 	}
 }
@@ -0,0 +1,1160 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package delivery
+
+import (
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/review"
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/word"
+	mock "github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewMockSubscriptions creates a new instance of MockSubscriptions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSubscriptions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSubscriptions {
+	mock := &MockSubscriptions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSubscriptions is an autogenerated mock type for the Subscriptions type
+type MockSubscriptions struct {
+	mock.Mock
+}
+
+type MockSubscriptions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSubscriptions) EXPECT() *MockSubscriptions_Expecter {
+	return &MockSubscriptions_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDelivery provides a mock function for the type MockSubscriptions
+func (_mock *MockSubscriptions) ClaimDelivery(subscription1 *subscription.Subscription, claimedAt time.Time) error {
+	ret := _mock.Called(subscription1, claimedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*subscription.Subscription, time.Time) error); ok {
+		r0 = returnFunc(subscription1, claimedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptions_ClaimDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDelivery'
+type MockSubscriptions_ClaimDelivery_Call struct {
+	*mock.Call
+}
+
+// ClaimDelivery is a helper method to define mock.On call
+//   - subscription1 *subscription.Subscription
+//   - claimedAt time.Time
+func (_e *MockSubscriptions_Expecter) ClaimDelivery(subscription1 interface{}, claimedAt interface{}) *MockSubscriptions_ClaimDelivery_Call {
+	return &MockSubscriptions_ClaimDelivery_Call{Call: _e.mock.On("ClaimDelivery", subscription1, claimedAt)}
+}
+
+func (_c *MockSubscriptions_ClaimDelivery_Call) Run(run func(subscription1 *subscription.Subscription, claimedAt time.Time)) *MockSubscriptions_ClaimDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *subscription.Subscription
+		if args[0] != nil {
+			arg0 = args[0].(*subscription.Subscription)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptions_ClaimDelivery_Call) Return(err error) *MockSubscriptions_ClaimDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptions_ClaimDelivery_Call) RunAndReturn(run func(subscription1 *subscription.Subscription, claimedAt time.Time) error) *MockSubscriptions_ClaimDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDueSubscriptions provides a mock function for the type MockSubscriptions
+func (_mock *MockSubscriptions) FindDueSubscriptions(now time.Time) ([]*subscription.Subscription, error) {
+	ret := _mock.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDueSubscriptions")
+	}
+
+	var r0 []*subscription.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(time.Time) ([]*subscription.Subscription, error)); ok {
+		return returnFunc(now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(time.Time) []*subscription.Subscription); ok {
+		r0 = returnFunc(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*subscription.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = returnFunc(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptions_FindDueSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDueSubscriptions'
+type MockSubscriptions_FindDueSubscriptions_Call struct {
+	*mock.Call
+}
+
+// FindDueSubscriptions is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockSubscriptions_Expecter) FindDueSubscriptions(now interface{}) *MockSubscriptions_FindDueSubscriptions_Call {
+	return &MockSubscriptions_FindDueSubscriptions_Call{Call: _e.mock.On("FindDueSubscriptions", now)}
+}
+
+func (_c *MockSubscriptions_FindDueSubscriptions_Call) Run(run func(now time.Time)) *MockSubscriptions_FindDueSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 time.Time
+		if args[0] != nil {
+			arg0 = args[0].(time.Time)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptions_FindDueSubscriptions_Call) Return(subscriptions []*subscription.Subscription, err error) *MockSubscriptions_FindDueSubscriptions_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptions_FindDueSubscriptions_Call) RunAndReturn(run func(now time.Time) ([]*subscription.Subscription, error)) *MockSubscriptions_FindDueSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreferencesURL provides a mock function for the type MockSubscriptions
+func (_mock *MockSubscriptions) PreferencesURL(subscriptionID string) (string, error) {
+	ret := _mock.Called(subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreferencesURL")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(subscriptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(subscriptionID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptions_PreferencesURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreferencesURL'
+type MockSubscriptions_PreferencesURL_Call struct {
+	*mock.Call
+}
+
+// PreferencesURL is a helper method to define mock.On call
+//   - subscriptionID string
+func (_e *MockSubscriptions_Expecter) PreferencesURL(subscriptionID interface{}) *MockSubscriptions_PreferencesURL_Call {
+	return &MockSubscriptions_PreferencesURL_Call{Call: _e.mock.On("PreferencesURL", subscriptionID)}
+}
+
+func (_c *MockSubscriptions_PreferencesURL_Call) Run(run func(subscriptionID string)) *MockSubscriptions_PreferencesURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptions_PreferencesURL_Call) Return(s string, err error) *MockSubscriptions_PreferencesURL_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockSubscriptions_PreferencesURL_Call) RunAndReturn(run func(subscriptionID string) (string, error)) *MockSubscriptions_PreferencesURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordDelivery provides a mock function for the type MockSubscriptions
+func (_mock *MockSubscriptions) RecordDelivery(subscription1 *subscription.Subscription, deliveredAt time.Time) error {
+	ret := _mock.Called(subscription1, deliveredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*subscription.Subscription, time.Time) error); ok {
+		r0 = returnFunc(subscription1, deliveredAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptions_RecordDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordDelivery'
+type MockSubscriptions_RecordDelivery_Call struct {
+	*mock.Call
+}
+
+// RecordDelivery is a helper method to define mock.On call
+//   - subscription1 *subscription.Subscription
+//   - deliveredAt time.Time
+func (_e *MockSubscriptions_Expecter) RecordDelivery(subscription1 interface{}, deliveredAt interface{}) *MockSubscriptions_RecordDelivery_Call {
+	return &MockSubscriptions_RecordDelivery_Call{Call: _e.mock.On("RecordDelivery", subscription1, deliveredAt)}
+}
+
+func (_c *MockSubscriptions_RecordDelivery_Call) Run(run func(subscription1 *subscription.Subscription, deliveredAt time.Time)) *MockSubscriptions_RecordDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *subscription.Subscription
+		if args[0] != nil {
+			arg0 = args[0].(*subscription.Subscription)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptions_RecordDelivery_Call) Return(err error) *MockSubscriptions_RecordDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptions_RecordDelivery_Call) RunAndReturn(run func(subscription1 *subscription.Subscription, deliveredAt time.Time) error) *MockSubscriptions_RecordDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFailedDelivery provides a mock function for the type MockSubscriptions
+func (_mock *MockSubscriptions) RecordFailedDelivery(subscription1 *subscription.Subscription, failedAt time.Time) error {
+	ret := _mock.Called(subscription1, failedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFailedDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*subscription.Subscription, time.Time) error); ok {
+		r0 = returnFunc(subscription1, failedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptions_RecordFailedDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFailedDelivery'
+type MockSubscriptions_RecordFailedDelivery_Call struct {
+	*mock.Call
+}
+
+// RecordFailedDelivery is a helper method to define mock.On call
+//   - subscription1 *subscription.Subscription
+//   - failedAt time.Time
+func (_e *MockSubscriptions_Expecter) RecordFailedDelivery(subscription1 interface{}, failedAt interface{}) *MockSubscriptions_RecordFailedDelivery_Call {
+	return &MockSubscriptions_RecordFailedDelivery_Call{Call: _e.mock.On("RecordFailedDelivery", subscription1, failedAt)}
+}
+
+func (_c *MockSubscriptions_RecordFailedDelivery_Call) Run(run func(subscription1 *subscription.Subscription, failedAt time.Time)) *MockSubscriptions_RecordFailedDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *subscription.Subscription
+		if args[0] != nil {
+			arg0 = args[0].(*subscription.Subscription)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptions_RecordFailedDelivery_Call) Return(err error) *MockSubscriptions_RecordFailedDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptions_RecordFailedDelivery_Call) RunAndReturn(run func(subscription1 *subscription.Subscription, failedAt time.Time) error) *MockSubscriptions_RecordFailedDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindBySubscription provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBySubscription(subscriptionID primitive.ObjectID) ([]*Delivery, error) {
+	ret := _mock.Called(subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySubscription")
+	}
+
+	var r0 []*Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) ([]*Delivery, error)); ok {
+		return returnFunc(subscriptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) []*Delivery); ok {
+		r0 = returnFunc(subscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = returnFunc(subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindBySubscription_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySubscription'
+type MockRepository_FindBySubscription_Call struct {
+	*mock.Call
+}
+
+// FindBySubscription is a helper method to define mock.On call
+//   - subscriptionID primitive.ObjectID
+func (_e *MockRepository_Expecter) FindBySubscription(subscriptionID interface{}) *MockRepository_FindBySubscription_Call {
+	return &MockRepository_FindBySubscription_Call{Call: _e.mock.On("FindBySubscription", subscriptionID)}
+}
+
+func (_c *MockRepository_FindBySubscription_Call) Run(run func(subscriptionID primitive.ObjectID)) *MockRepository_FindBySubscription_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindBySubscription_Call) Return(deliverys []*Delivery, err error) *MockRepository_FindBySubscription_Call {
+	_c.Call.Return(deliverys, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBySubscription_Call) RunAndReturn(run func(subscriptionID primitive.ObjectID) ([]*Delivery, error)) *MockRepository_FindBySubscription_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDeliveredWordIDs provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindDeliveredWordIDs(subscriptionID primitive.ObjectID, since time.Time) ([]primitive.ObjectID, error) {
+	ret := _mock.Called(subscriptionID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDeliveredWordIDs")
+	}
+
+	var r0 []primitive.ObjectID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, time.Time) ([]primitive.ObjectID, error)); ok {
+		return returnFunc(subscriptionID, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, time.Time) []primitive.ObjectID); ok {
+		r0 = returnFunc(subscriptionID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]primitive.ObjectID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID, time.Time) error); ok {
+		r1 = returnFunc(subscriptionID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindDeliveredWordIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeliveredWordIDs'
+type MockRepository_FindDeliveredWordIDs_Call struct {
+	*mock.Call
+}
+
+// FindDeliveredWordIDs is a helper method to define mock.On call
+//   - subscriptionID primitive.ObjectID
+//   - since time.Time
+func (_e *MockRepository_Expecter) FindDeliveredWordIDs(subscriptionID interface{}, since interface{}) *MockRepository_FindDeliveredWordIDs_Call {
+	return &MockRepository_FindDeliveredWordIDs_Call{Call: _e.mock.On("FindDeliveredWordIDs", subscriptionID, since)}
+}
+
+func (_c *MockRepository_FindDeliveredWordIDs_Call) Run(run func(subscriptionID primitive.ObjectID, since time.Time)) *MockRepository_FindDeliveredWordIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindDeliveredWordIDs_Call) Return(objectIDs []primitive.ObjectID, err error) *MockRepository_FindDeliveredWordIDs_Call {
+	_c.Call.Return(objectIDs, err)
+	return _c
+}
+
+func (_c *MockRepository_FindDeliveredWordIDs_Call) RunAndReturn(run func(subscriptionID primitive.ObjectID, since time.Time) ([]primitive.ObjectID, error)) *MockRepository_FindDeliveredWordIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveDelivery provides a mock function for the type MockRepository
+func (_mock *MockRepository) SaveDelivery(delivery *Delivery) (*Delivery, error) {
+	ret := _mock.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDelivery")
+	}
+
+	var r0 *Delivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*Delivery) (*Delivery, error)); ok {
+		return returnFunc(delivery)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*Delivery) *Delivery); ok {
+		r0 = returnFunc(delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Delivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*Delivery) error); ok {
+		r1 = returnFunc(delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_SaveDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveDelivery'
+type MockRepository_SaveDelivery_Call struct {
+	*mock.Call
+}
+
+// SaveDelivery is a helper method to define mock.On call
+//   - delivery *Delivery
+func (_e *MockRepository_Expecter) SaveDelivery(delivery interface{}) *MockRepository_SaveDelivery_Call {
+	return &MockRepository_SaveDelivery_Call{Call: _e.mock.On("SaveDelivery", delivery)}
+}
+
+func (_c *MockRepository_SaveDelivery_Call) Run(run func(delivery *Delivery)) *MockRepository_SaveDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Delivery
+		if args[0] != nil {
+			arg0 = args[0].(*Delivery)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveDelivery_Call) Return(delivery1 *Delivery, err error) *MockRepository_SaveDelivery_Call {
+	_c.Call.Return(delivery1, err)
+	return _c
+}
+
+func (_c *MockRepository_SaveDelivery_Call) RunAndReturn(run func(delivery *Delivery) (*Delivery, error)) *MockRepository_SaveDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWords creates a new instance of MockWords. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWords(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWords {
+	mock := &MockWords{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWords is an autogenerated mock type for the Words type
+type MockWords struct {
+	mock.Mock
+}
+
+type MockWords_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWords) EXPECT() *MockWords_Expecter {
+	return &MockWords_Expecter{mock: &_m.Mock}
+}
+
+// MarkDelivered provides a mock function for the type MockWords
+func (_mock *MockWords) MarkDelivered(word1 *word.Word, deliveredAt time.Time) error {
+	ret := _mock.Called(word1, deliveredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkDelivered")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*word.Word, time.Time) error); ok {
+		r0 = returnFunc(word1, deliveredAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWords_MarkDelivered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkDelivered'
+type MockWords_MarkDelivered_Call struct {
+	*mock.Call
+}
+
+// MarkDelivered is a helper method to define mock.On call
+//   - word1 *word.Word
+//   - deliveredAt time.Time
+func (_e *MockWords_Expecter) MarkDelivered(word1 interface{}, deliveredAt interface{}) *MockWords_MarkDelivered_Call {
+	return &MockWords_MarkDelivered_Call{Call: _e.mock.On("MarkDelivered", word1, deliveredAt)}
+}
+
+func (_c *MockWords_MarkDelivered_Call) Run(run func(word1 *word.Word, deliveredAt time.Time)) *MockWords_MarkDelivered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *word.Word
+		if args[0] != nil {
+			arg0 = args[0].(*word.Word)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_MarkDelivered_Call) Return(err error) *MockWords_MarkDelivered_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWords_MarkDelivered_Call) RunAndReturn(run func(word1 *word.Word, deliveredAt time.Time) error) *MockWords_MarkDelivered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NextWord provides a mock function for the type MockWords
+func (_mock *MockWords) NextWord(selection *word.Selection) (*word.Word, error) {
+	ret := _mock.Called(selection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NextWord")
+	}
+
+	var r0 *word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*word.Selection) (*word.Word, error)); ok {
+		return returnFunc(selection)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*word.Selection) *word.Word); ok {
+		r0 = returnFunc(selection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*word.Selection) error); ok {
+		r1 = returnFunc(selection)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_NextWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NextWord'
+type MockWords_NextWord_Call struct {
+	*mock.Call
+}
+
+// NextWord is a helper method to define mock.On call
+//   - selection *word.Selection
+func (_e *MockWords_Expecter) NextWord(selection interface{}) *MockWords_NextWord_Call {
+	return &MockWords_NextWord_Call{Call: _e.mock.On("NextWord", selection)}
+}
+
+func (_c *MockWords_NextWord_Call) Run(run func(selection *word.Selection)) *MockWords_NextWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *word.Selection
+		if args[0] != nil {
+			arg0 = args[0].(*word.Selection)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_NextWord_Call) Return(word1 *word.Word, err error) *MockWords_NextWord_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockWords_NextWord_Call) RunAndReturn(run func(selection *word.Selection) (*word.Word, error)) *MockWords_NextWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSchedule creates a new instance of MockSchedule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSchedule(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSchedule {
+	mock := &MockSchedule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSchedule is an autogenerated mock type for the Schedule type
+type MockSchedule struct {
+	mock.Mock
+}
+
+type MockSchedule_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSchedule) EXPECT() *MockSchedule_Expecter {
+	return &MockSchedule_Expecter{mock: &_m.Mock}
+}
+
+// Backfill provides a mock function for the type MockSchedule
+func (_mock *MockSchedule) Backfill(today string) (int, error) {
+	ret := _mock.Called(today)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backfill")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return returnFunc(today)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) int); ok {
+		r0 = returnFunc(today)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(today)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchedule_Backfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backfill'
+type MockSchedule_Backfill_Call struct {
+	*mock.Call
+}
+
+// Backfill is a helper method to define mock.On call
+//   - today string
+func (_e *MockSchedule_Expecter) Backfill(today interface{}) *MockSchedule_Backfill_Call {
+	return &MockSchedule_Backfill_Call{Call: _e.mock.On("Backfill", today)}
+}
+
+func (_c *MockSchedule_Backfill_Call) Run(run func(today string)) *MockSchedule_Backfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchedule_Backfill_Call) Return(n int, err error) *MockSchedule_Backfill_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockSchedule_Backfill_Call) RunAndReturn(run func(today string) (int, error)) *MockSchedule_Backfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReservedWordIDs provides a mock function for the type MockSchedule
+func (_mock *MockSchedule) ReservedWordIDs(date string) ([]primitive.ObjectID, error) {
+	ret := _mock.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReservedWordIDs")
+	}
+
+	var r0 []primitive.ObjectID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]primitive.ObjectID, error)); ok {
+		return returnFunc(date)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []primitive.ObjectID); ok {
+		r0 = returnFunc(date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]primitive.ObjectID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchedule_ReservedWordIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReservedWordIDs'
+type MockSchedule_ReservedWordIDs_Call struct {
+	*mock.Call
+}
+
+// ReservedWordIDs is a helper method to define mock.On call
+//   - date string
+func (_e *MockSchedule_Expecter) ReservedWordIDs(date interface{}) *MockSchedule_ReservedWordIDs_Call {
+	return &MockSchedule_ReservedWordIDs_Call{Call: _e.mock.On("ReservedWordIDs", date)}
+}
+
+func (_c *MockSchedule_ReservedWordIDs_Call) Run(run func(date string)) *MockSchedule_ReservedWordIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchedule_ReservedWordIDs_Call) Return(objectIDs []primitive.ObjectID, err error) *MockSchedule_ReservedWordIDs_Call {
+	_c.Call.Return(objectIDs, err)
+	return _c
+}
+
+func (_c *MockSchedule_ReservedWordIDs_Call) RunAndReturn(run func(date string) ([]primitive.ObjectID, error)) *MockSchedule_ReservedWordIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WordFor provides a mock function for the type MockSchedule
+func (_mock *MockSchedule) WordFor(date string) (*word.Word, error) {
+	ret := _mock.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WordFor")
+	}
+
+	var r0 *word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*word.Word, error)); ok {
+		return returnFunc(date)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *word.Word); ok {
+		r0 = returnFunc(date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSchedule_WordFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WordFor'
+type MockSchedule_WordFor_Call struct {
+	*mock.Call
+}
+
+// WordFor is a helper method to define mock.On call
+//   - date string
+func (_e *MockSchedule_Expecter) WordFor(date interface{}) *MockSchedule_WordFor_Call {
+	return &MockSchedule_WordFor_Call{Call: _e.mock.On("WordFor", date)}
+}
+
+func (_c *MockSchedule_WordFor_Call) Run(run func(date string)) *MockSchedule_WordFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSchedule_WordFor_Call) Return(word1 *word.Word, err error) *MockSchedule_WordFor_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockSchedule_WordFor_Call) RunAndReturn(run func(date string) (*word.Word, error)) *MockSchedule_WordFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReviews creates a new instance of MockReviews. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReviews(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReviews {
+	mock := &MockReviews{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockReviews is an autogenerated mock type for the Reviews type
+type MockReviews struct {
+	mock.Mock
+}
+
+type MockReviews_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReviews) EXPECT() *MockReviews_Expecter {
+	return &MockReviews_Expecter{mock: &_m.Mock}
+}
+
+// DueReviews provides a mock function for the type MockReviews
+func (_mock *MockReviews) DueReviews(subscriptionID primitive.ObjectID, now time.Time) ([]*review.DueReview, error) {
+	ret := _mock.Called(subscriptionID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DueReviews")
+	}
+
+	var r0 []*review.DueReview
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, time.Time) ([]*review.DueReview, error)); ok {
+		return returnFunc(subscriptionID, now)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, time.Time) []*review.DueReview); ok {
+		r0 = returnFunc(subscriptionID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*review.DueReview)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID, time.Time) error); ok {
+		r1 = returnFunc(subscriptionID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReviews_DueReviews_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DueReviews'
+type MockReviews_DueReviews_Call struct {
+	*mock.Call
+}
+
+// DueReviews is a helper method to define mock.On call
+//   - subscriptionID primitive.ObjectID
+//   - now time.Time
+func (_e *MockReviews_Expecter) DueReviews(subscriptionID interface{}, now interface{}) *MockReviews_DueReviews_Call {
+	return &MockReviews_DueReviews_Call{Call: _e.mock.On("DueReviews", subscriptionID, now)}
+}
+
+func (_c *MockReviews_DueReviews_Call) Run(run func(subscriptionID primitive.ObjectID, now time.Time)) *MockReviews_DueReviews_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReviews_DueReviews_Call) Return(dueReviews []*review.DueReview, err error) *MockReviews_DueReviews_Call {
+	_c.Call.Return(dueReviews, err)
+	return _c
+}
+
+func (_c *MockReviews_DueReviews_Call) RunAndReturn(run func(subscriptionID primitive.ObjectID, now time.Time) ([]*review.DueReview, error)) *MockReviews_DueReviews_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schedule provides a mock function for the type MockReviews
+func (_mock *MockReviews) Schedule(subscriptionID primitive.ObjectID, wordID primitive.ObjectID, deliveredAt time.Time) error {
+	ret := _mock.Called(subscriptionID, wordID, deliveredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schedule")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, primitive.ObjectID, time.Time) error); ok {
+		r0 = returnFunc(subscriptionID, wordID, deliveredAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockReviews_Schedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schedule'
+type MockReviews_Schedule_Call struct {
+	*mock.Call
+}
+
+// Schedule is a helper method to define mock.On call
+//   - subscriptionID primitive.ObjectID
+//   - wordID primitive.ObjectID
+//   - deliveredAt time.Time
+func (_e *MockReviews_Expecter) Schedule(subscriptionID interface{}, wordID interface{}, deliveredAt interface{}) *MockReviews_Schedule_Call {
+	return &MockReviews_Schedule_Call{Call: _e.mock.On("Schedule", subscriptionID, wordID, deliveredAt)}
+}
+
+func (_c *MockReviews_Schedule_Call) Run(run func(subscriptionID primitive.ObjectID, wordID primitive.ObjectID, deliveredAt time.Time)) *MockReviews_Schedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 primitive.ObjectID
+		if args[1] != nil {
+			arg1 = args[1].(primitive.ObjectID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReviews_Schedule_Call) Return(err error) *MockReviews_Schedule_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockReviews_Schedule_Call) RunAndReturn(run func(subscriptionID primitive.ObjectID, wordID primitive.ObjectID, deliveredAt time.Time) error) *MockReviews_Schedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMailSender creates a new instance of MockMailSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMailSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMailSender {
+	mock := &MockMailSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMailSender is an autogenerated mock type for the MailSender type
+type MockMailSender struct {
+	mock.Mock
+}
+
+type MockMailSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMailSender) EXPECT() *MockMailSender_Expecter {
+	return &MockMailSender_Expecter{mock: &_m.Mock}
+}
+
+// SendDailyWordEmail provides a mock function for the type MockMailSender
+func (_mock *MockMailSender) SendDailyWordEmail(recipient *email.Recipient, dailyWord *word.Word, reviews []email.ReviewItem) error {
+	ret := _mock.Called(recipient, dailyWord, reviews)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDailyWordEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*email.Recipient, *word.Word, []email.ReviewItem) error); ok {
+		r0 = returnFunc(recipient, dailyWord, reviews)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMailSender_SendDailyWordEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDailyWordEmail'
+type MockMailSender_SendDailyWordEmail_Call struct {
+	*mock.Call
+}
+
+// SendDailyWordEmail is a helper method to define mock.On call
+//   - recipient *email.Recipient
+//   - dailyWord *word.Word
+//   - reviews []email.ReviewItem
+func (_e *MockMailSender_Expecter) SendDailyWordEmail(recipient interface{}, dailyWord interface{}, reviews interface{}) *MockMailSender_SendDailyWordEmail_Call {
+	return &MockMailSender_SendDailyWordEmail_Call{Call: _e.mock.On("SendDailyWordEmail", recipient, dailyWord, reviews)}
+}
+
+func (_c *MockMailSender_SendDailyWordEmail_Call) Run(run func(recipient *email.Recipient, dailyWord *word.Word, reviews []email.ReviewItem)) *MockMailSender_SendDailyWordEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *email.Recipient
+		if args[0] != nil {
+			arg0 = args[0].(*email.Recipient)
+		}
+		var arg1 *word.Word
+		if args[1] != nil {
+			arg1 = args[1].(*word.Word)
+		}
+		var arg2 []email.ReviewItem
+		if args[2] != nil {
+			arg2 = args[2].([]email.ReviewItem)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMailSender_SendDailyWordEmail_Call) Return(err error) *MockMailSender_SendDailyWordEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMailSender_SendDailyWordEmail_Call) RunAndReturn(run func(recipient *email.Recipient, dailyWord *word.Word, reviews []email.ReviewItem) error) *MockMailSender_SendDailyWordEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,24 @@
+package delivery
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Status string
+
+const (
+	StatusSent   Status = "sent"
+	StatusFailed Status = "failed"
+)
+
+// Delivery records one attempt to send a word to a subscription.
+type Delivery struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	SubscriptionID primitive.ObjectID `bson:"subscription_id" json:"subscription_id"`
+	WordID         primitive.ObjectID `bson:"word_id" json:"word_id"`
+	SentAt         time.Time          `bson:"sent_at" json:"sent_at"`
+	Status         Status             `bson:"status" json:"status"`
+	Error          string             `bson:"error,omitempty" json:"error,omitempty"`
+}
@@ -0,0 +1,79 @@
+package delivery
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const collectionName = "deliveries"
+
+type MongoRepository struct {
+	collection *mongo.Collection
+}
+
+func NewDeliveryRepo(db *mongo.Database) *MongoRepository {
+	return &MongoRepository{
+		collection: db.Collection(collectionName),
+	}
+}
+
+func (r *MongoRepository) SaveDelivery(delivery *Delivery) (*Delivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := r.collection.InsertOne(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.ID = result.InsertedID.(primitive.ObjectID)
+	return delivery, nil
+}
+
+// FindDeliveredWordIDs returns the IDs of the words successfully sent to the subscription at or after since.
+// A zero since returns every word the subscription has received.
+func (r *MongoRepository) FindDeliveredWordIDs(subscriptionID primitive.ObjectID, since time.Time) ([]primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"subscription_id": subscriptionID, "status": StatusSent}
+	if !since.IsZero() {
+		filter["sent_at"] = bson.M{"$gte": since}
+	}
+
+	values, err := r.collection.Distinct(ctx, "word_id", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	wordIDs := make([]primitive.ObjectID, 0, len(values))
+	for _, value := range values {
+		if wordID, ok := value.(primitive.ObjectID); ok {
+			wordIDs = append(wordIDs, wordID)
+		}
+	}
+	return wordIDs, nil
+}
+
+// FindBySubscription returns the delivery history of a subscription, newest first.
+func (r *MongoRepository) FindBySubscription(subscriptionID primitive.ObjectID) ([]*Delivery, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "sent_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"subscription_id": subscriptionID}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []*Delivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
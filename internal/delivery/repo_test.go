@@ -0,0 +1,72 @@
+package delivery
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/infra/testhelper"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DeliveryRepoTestSuite struct {
+	suite.Suite
+	database *testhelper.TestDatabase
+	repo     *MongoRepository
+}
+
+func (suite *DeliveryRepoTestSuite) SetupSuite() {
+	log.Println("Setting up DeliveryRepoTestSuite...")
+	suite.database = testhelper.SetupTestDatabase()
+	suite.repo = NewDeliveryRepo(suite.database.DbInstance)
+}
+
+func (suite *DeliveryRepoTestSuite) TearDownSuite() {
+	log.Println("Tearing down DeliveryRepoTestSuite...")
+	suite.database.TearDown()
+}
+
+func (suite *DeliveryRepoTestSuite) BeforeTest(suiteName, testName string) {
+	log.Printf("Before test: %s - %s\n", suiteName, testName)
+	if err := suite.database.CleanUp(); err != nil {
+		log.Fatalf("Failed to clean up database before test: %v", err)
+	}
+}
+
+func TestDeliveryRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryRepoTestSuite))
+}
+
+func (suite *DeliveryRepoTestSuite) TestDeliveryRepository_FindDeliveredWordIDs() {
+	suite.Run("Only successful deliveries of the subscription", func() {
+		subscriptionID := primitive.NewObjectID()
+		sentWord := primitive.NewObjectID()
+		failedWord := primitive.NewObjectID()
+		_, _ = suite.repo.SaveDelivery(&Delivery{SubscriptionID: subscriptionID, WordID: sentWord, SentAt: time.Now(), Status: StatusSent})
+		_, _ = suite.repo.SaveDelivery(&Delivery{SubscriptionID: subscriptionID, WordID: failedWord, SentAt: time.Now(), Status: StatusFailed})
+		_, _ = suite.repo.SaveDelivery(&Delivery{SubscriptionID: primitive.NewObjectID(), WordID: failedWord, SentAt: time.Now(), Status: StatusSent})
+
+		wordIDs, err := suite.repo.FindDeliveredWordIDs(subscriptionID, time.Time{})
+		suite.NoError(err, "Expected no error when finding delivered word IDs")
+		suite.Equal([]primitive.ObjectID{sentWord}, wordIDs)
+
+		wordIDs, err = suite.repo.FindDeliveredWordIDs(subscriptionID, time.Now().Add(time.Hour))
+		suite.NoError(err, "Expected no error when finding recently delivered word IDs")
+		suite.Empty(wordIDs)
+	})
+}
+
+func (suite *DeliveryRepoTestSuite) TestDeliveryRepository_FindBySubscription() {
+	suite.Run("Newest first", func() {
+		subscriptionID := primitive.NewObjectID()
+		older, _ := suite.repo.SaveDelivery(&Delivery{SubscriptionID: subscriptionID, WordID: primitive.NewObjectID(), SentAt: time.Now().Add(-24 * time.Hour), Status: StatusSent})
+		newer, _ := suite.repo.SaveDelivery(&Delivery{SubscriptionID: subscriptionID, WordID: primitive.NewObjectID(), SentAt: time.Now(), Status: StatusSent})
+
+		deliveries, err := suite.repo.FindBySubscription(subscriptionID)
+		suite.NoError(err, "Expected no error when finding deliveries")
+		suite.Len(deliveries, 2)
+		suite.Equal(newer.ID, deliveries[0].ID)
+		suite.Equal(older.ID, deliveries[1].ID)
+	})
+}
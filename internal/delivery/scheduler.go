@@ -0,0 +1,44 @@
+package delivery
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const DefaultInterval = time.Minute
+
+// Scheduler runs DeliverDue on a fixed interval so each subscriber is mailed shortly after their preferred send time.
+type Scheduler struct {
+	service  *Service
+	interval time.Duration
+}
+
+func NewScheduler(service *Service, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return &Scheduler{service: service, interval: interval}
+}
+
+// Run blocks until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			sent, err := s.service.DeliverDue(now)
+			if err != nil {
+				log.Printf("Failed to deliver daily words: %v", err)
+				continue
+			}
+			if sent > 0 {
+				log.Printf("✅ Delivered daily word to %d subscribers", sent)
+			}
+		}
+	}
+}
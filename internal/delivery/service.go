@@ -0,0 +1,233 @@
+package delivery
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/review"
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Subscriptions interface {
+	FindDueSubscriptions(now time.Time) ([]*subscription.Subscription, error)
+	ClaimDelivery(subscription *subscription.Subscription, claimedAt time.Time) error
+	RecordDelivery(subscription *subscription.Subscription, deliveredAt time.Time) error
+	RecordFailedDelivery(subscription *subscription.Subscription, failedAt time.Time) error
+	PreferencesURL(subscriptionID string) (string, error)
+}
+
+type Repository interface {
+	SaveDelivery(delivery *Delivery) (*Delivery, error)
+	FindDeliveredWordIDs(subscriptionID primitive.ObjectID, since time.Time) ([]primitive.ObjectID, error)
+	FindBySubscription(subscriptionID primitive.ObjectID) ([]*Delivery, error)
+}
+
+type Words interface {
+	NextWord(selection *word.Selection) (*word.Word, error)
+	MarkDelivered(word *word.Word, deliveredAt time.Time) error
+}
+
+// Schedule is the editors' plan of which word goes out on which date.
+type Schedule interface {
+	Backfill(today string) (int, error)
+	WordFor(date string) (*word.Word, error)
+	ReservedWordIDs(date string) ([]primitive.ObjectID, error)
+}
+
+type Reviews interface {
+	DueReviews(subscriptionID primitive.ObjectID, now time.Time) ([]*review.DueReview, error)
+	Schedule(subscriptionID, wordID primitive.ObjectID, deliveredAt time.Time) error
+}
+
+type MailSender interface {
+	SendDailyWordEmail(recipient *email.Recipient, dailyWord *word.Word, reviews []email.ReviewItem) error
+}
+
+type Service struct {
+	repository    Repository
+	subscriptions Subscriptions
+	words         Words
+	schedule      Schedule
+	reviews       Reviews
+	mailSender    MailSender
+}
+
+func NewDeliveryService(
+	repo Repository,
+	subscriptions Subscriptions,
+	words Words,
+	schedule Schedule,
+	reviews Reviews,
+	mailSender MailSender,
+) *Service {
+	return &Service{
+		repository:    repo,
+		subscriptions: subscriptions,
+		words:         words,
+		schedule:      schedule,
+		reviews:       reviews,
+		mailSender:    mailSender,
+	}
+}
+
+// DeliverDue sends every subscription whose preferences call for a delivery at now the next word it has not
+// received yet. A failed send is recorded and retried with backoff; it returns the number of emails sent.
+func (s *Service) DeliverDue(now time.Time) (int, error) {
+	due, err := s.subscriptions.FindDueSubscriptions(now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find due subscriptions: %w", err)
+	}
+	if len(due) > 0 {
+		s.backfill(due, now)
+	}
+
+	sent := 0
+	for _, sub := range due {
+		err := s.deliverNext(sub, now)
+		if errors.Is(err, word.ErrNoWordAvailable) {
+			log.Printf("Subscription %s has received every word", sub.ID.Hex())
+			continue
+		}
+		if err != nil {
+			log.Printf("Failed to deliver to %s: %v", sub.Email, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// History returns the deliveries made to a subscription, newest first.
+func (s *Service) History(subscriptionID string) ([]*Delivery, error) {
+	objectID, err := primitive.ObjectIDFromHex(subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subscription ID: %w", err)
+	}
+	return s.repository.FindBySubscription(objectID)
+}
+
+// DeliveredWordIDs returns the words successfully sent to the subscription at or after since.
+func (s *Service) DeliveredWordIDs(subscriptionID primitive.ObjectID, since time.Time) ([]primitive.ObjectID, error) {
+	return s.repository.FindDeliveredWordIDs(subscriptionID, since)
+}
+
+func (s *Service) deliverNext(sub *subscription.Subscription, now time.Time) error {
+	deliveredIDs, err := s.repository.FindDeliveredWordIDs(sub.ID, time.Time{})
+	if err != nil {
+		return fmt.Errorf("failed to find delivered words: %w", err)
+	}
+
+	nextWord, err := s.pickWord(sub, &word.Selection{
+		ExcludeIDs: deliveredIDs,
+		Levels:     sub.Preferences.Levels,
+		Tags:       sub.Preferences.Tags,
+	}, now)
+	if err != nil {
+		return err
+	}
+
+	// Claim the day before sending, so that a failure from here on cannot leave the subscription due and
+	// have the next run mail it another word.
+	if err := s.subscriptions.ClaimDelivery(sub, now); err != nil {
+		return fmt.Errorf("failed to claim delivery: %w", err)
+	}
+
+	delivery := &Delivery{SubscriptionID: sub.ID, WordID: nextWord.ID, SentAt: now, Status: StatusSent}
+	sendErr := s.send(sub, nextWord, now)
+	if sendErr != nil {
+		delivery.Status = StatusFailed
+		delivery.Error = sendErr.Error()
+	}
+
+	if _, err := s.repository.SaveDelivery(delivery); err != nil {
+		log.Printf("Failed to save delivery of %s to %s: %v", nextWord.Text, sub.Email, err)
+	}
+	if sendErr != nil {
+		if err := s.subscriptions.RecordFailedDelivery(sub, now); err != nil {
+			log.Printf("Failed to schedule a retry for %s: %v", sub.Email, err)
+		}
+		return sendErr
+	}
+
+	if err := s.words.MarkDelivered(nextWord, now); err != nil {
+		log.Printf("Failed to mark %s delivered: %v", nextWord.Text, err)
+	}
+	if err := s.reviews.Schedule(sub.ID, nextWord.ID, now); err != nil {
+		log.Printf("Failed to schedule review of %s for %s: %v", nextWord.Text, sub.Email, err)
+	}
+	if err := s.subscriptions.RecordDelivery(sub, now); err != nil {
+		log.Printf("Failed to record delivery to %s: %v", sub.Email, err)
+	}
+	return nil
+}
+
+// backfill fills the empty dates of the schedule once per run, from the earliest local date of the due
+// subscriptions, so each of them finds a word scheduled on their date.
+func (s *Service) backfill(due []*subscription.Subscription, now time.Time) {
+	from := due[0].LocalDate(now)
+	for _, sub := range due[1:] {
+		from = min(from, sub.LocalDate(now))
+	}
+	if _, err := s.schedule.Backfill(from); err != nil {
+		log.Printf("Failed to back-fill the schedule from %s: %v", from, err)
+	}
+}
+
+// pickWord returns the word scheduled for the subscriber's local date when the subscriber has not had it and
+// it fits their track. Otherwise it falls back to the oldest word they have not received, keeping words that
+// are scheduled for a later date for their day.
+func (s *Service) pickWord(sub *subscription.Subscription, selection *word.Selection, now time.Time) (*word.Word, error) {
+	date := sub.LocalDate(now)
+	scheduled, err := s.schedule.WordFor(date)
+	if err != nil {
+		log.Printf("Failed to find the word scheduled on %s: %v", date, err)
+	}
+	if scheduled != nil && selection.Matches(scheduled) {
+		return scheduled, nil
+	}
+
+	reserved, err := s.schedule.ReservedWordIDs(date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scheduled words: %w", err)
+	}
+	selection.ExcludeIDs = append(slices.Clone(selection.ExcludeIDs), reserved...)
+	return s.words.NextWord(selection)
+}
+
+func (s *Service) send(sub *subscription.Subscription, nextWord *word.Word, now time.Time) error {
+	subscriptionID := sub.ID.Hex()
+	preferencesLink, err := s.subscriptions.PreferencesURL(subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	recipient := &email.Recipient{
+		SubscriptionID:  subscriptionID,
+		Email:           sub.Email,
+		Username:        sub.Username,
+		TrackingOptOut:  sub.TrackingOptOut,
+		PreferencesLink: preferencesLink,
+	}
+
+	// A failure to load reviews should not hold back the new word.
+	dueReviews, err := s.reviews.DueReviews(sub.ID, now)
+	if err != nil {
+		log.Printf("Failed to load due reviews for %s: %v", sub.Email, err)
+	}
+	reviewItems := make([]email.ReviewItem, 0, len(dueReviews))
+	for _, dueReview := range dueReviews {
+		reviewItems = append(reviewItems, email.ReviewItem{
+			Word:           dueReview.Word,
+			RememberedLink: dueReview.RememberedLink,
+			ForgotLink:     dueReview.ForgotLink,
+		})
+	}
+
+	return s.mailSender.SendDailyWordEmail(recipient, nextWord, reviewItems)
+}
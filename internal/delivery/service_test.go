@@ -0,0 +1,312 @@
+package delivery
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/review"
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DeliveryServiceTestSuite struct {
+	suite.Suite
+	mockRepo          *MockRepository
+	mockSubscriptions *MockSubscriptions
+	mockWords         *MockWords
+	mockSchedule      *MockSchedule
+	mockReviews       *MockReviews
+	mockMailSender    *MockMailSender
+	service           *Service
+}
+
+func (suite *DeliveryServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockRepository)
+	suite.mockSubscriptions = new(MockSubscriptions)
+	suite.mockWords = new(MockWords)
+	suite.mockSchedule = new(MockSchedule)
+	suite.mockReviews = new(MockReviews)
+	suite.mockMailSender = new(MockMailSender)
+	suite.service = NewDeliveryService(
+		suite.mockRepo, suite.mockSubscriptions, suite.mockWords, suite.mockSchedule, suite.mockReviews, suite.mockMailSender,
+	)
+}
+
+// emptySchedule makes the schedule have nothing planned, so every subscription gets its next word.
+func (suite *DeliveryServiceTestSuite) emptySchedule() {
+	suite.mockSchedule.EXPECT().Backfill(mock.Anything).Return(0, nil)
+	suite.mockSchedule.EXPECT().WordFor(mock.Anything).Return(nil, nil)
+	suite.mockSchedule.EXPECT().ReservedWordIDs(mock.Anything).Return(nil, nil)
+}
+
+func TestDeliveryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryServiceTestSuite))
+}
+
+func subscriptionFixture(email string) *subscription.Subscription {
+	return &subscription.Subscription{
+		ID:       primitive.NewObjectID(),
+		Email:    email,
+		Username: "tester",
+		Verified: true,
+	}
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_EachSubscriptionGetsItsOwnNextWordAndDueReviews() {
+	// Given
+	suite.emptySchedule()
+	now := time.Now()
+	newcomer := subscriptionFixture("newcomer@example.com")
+	veteran := subscriptionFixture("veteran@example.com")
+	veteran.Preferences.Levels = []word.Level{word.LevelC1}
+	veteran.Preferences.Tags = []string{"business"}
+	firstWord := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity"}
+	secondWord := &word.Word{ID: primitive.NewObjectID(), Text: "ephemeral"}
+
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return([]*subscription.Subscription{newcomer, veteran}, nil)
+	suite.mockSubscriptions.EXPECT().ClaimDelivery(mock.Anything, now).Return(nil)
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(newcomer.ID, time.Time{}).Return(nil, nil)
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(veteran.ID, time.Time{}).Return([]primitive.ObjectID{firstWord.ID}, nil)
+	suite.mockWords.EXPECT().NextWord(&word.Selection{}).Return(firstWord, nil)
+	suite.mockWords.EXPECT().NextWord(&word.Selection{
+		ExcludeIDs: []primitive.ObjectID{firstWord.ID},
+		Levels:     []word.Level{word.LevelC1},
+		Tags:       []string{"business"},
+	}).Return(secondWord, nil)
+	suite.mockSubscriptions.EXPECT().PreferencesURL(mock.Anything).Return("https://wordrop.com/preferences", nil)
+	suite.mockReviews.EXPECT().DueReviews(newcomer.ID, now).Return(nil, nil)
+	suite.mockReviews.EXPECT().DueReviews(veteran.ID, now).Return([]*review.DueReview{
+		{Word: firstWord, RememberedLink: "https://wordrop.com/reviews/answer/yes", ForgotLink: "https://wordrop.com/reviews/answer/no"},
+	}, nil)
+	suite.mockMailSender.EXPECT().SendDailyWordEmail(mock.MatchedBy(func(recipient *email.Recipient) bool {
+		return recipient.Email == newcomer.Email
+	}), firstWord, []email.ReviewItem{}).Return(nil)
+	suite.mockMailSender.EXPECT().SendDailyWordEmail(mock.MatchedBy(func(recipient *email.Recipient) bool {
+		return recipient.Email == veteran.Email
+	}), secondWord, []email.ReviewItem{
+		{Word: firstWord, RememberedLink: "https://wordrop.com/reviews/answer/yes", ForgotLink: "https://wordrop.com/reviews/answer/no"},
+	}).Return(nil)
+	suite.mockReviews.EXPECT().Schedule(newcomer.ID, firstWord.ID, now).Return(nil)
+	suite.mockReviews.EXPECT().Schedule(veteran.ID, secondWord.ID, now).Return(nil)
+	suite.mockRepo.EXPECT().SaveDelivery(mock.MatchedBy(func(delivery *Delivery) bool {
+		return delivery.Status == StatusSent && delivery.SentAt.Equal(now)
+	})).Return(&Delivery{}, nil)
+	suite.mockWords.EXPECT().MarkDelivered(mock.Anything, now).Return(nil)
+	suite.mockSubscriptions.EXPECT().RecordDelivery(mock.Anything, now).Return(nil)
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(2, sent)
+	suite.mockMailSender.AssertExpectations(suite.T())
+	suite.mockRepo.AssertNumberOfCalls(suite.T(), "SaveDelivery", 2)
+	suite.mockSchedule.AssertNumberOfCalls(suite.T(), "Backfill", 1)
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_BackfillsOnceFromTheEarliestLocalDate() {
+	// Given
+	now := time.Date(2025, 8, 6, 2, 0, 0, 0, time.UTC)
+	seoul := subscriptionFixture("seoul@example.com") // 11:00 on the 6th
+	newYork := subscriptionFixture("new-york@example.com")
+	newYork.Preferences.Timezone = "America/New_York" // 22:00 on the 5th
+	scheduled := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity", Status: word.StatusScheduled}
+
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return([]*subscription.Subscription{seoul, newYork}, nil)
+	suite.mockSchedule.EXPECT().Backfill("2025-08-05").Return(1, nil).Once()
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(mock.Anything, time.Time{}).Return(nil, nil)
+	suite.mockSchedule.EXPECT().WordFor(mock.Anything).Return(scheduled, nil)
+	suite.mockSubscriptions.EXPECT().ClaimDelivery(mock.Anything, now).Return(nil)
+	suite.mockSubscriptions.EXPECT().PreferencesURL(mock.Anything).Return("", nil)
+	suite.mockReviews.EXPECT().DueReviews(mock.Anything, now).Return(nil, nil)
+	suite.mockMailSender.EXPECT().SendDailyWordEmail(mock.Anything, scheduled, mock.Anything).Return(nil)
+	suite.mockRepo.EXPECT().SaveDelivery(mock.Anything).Return(&Delivery{}, nil)
+	suite.mockWords.EXPECT().MarkDelivered(scheduled, now).Return(nil)
+	suite.mockReviews.EXPECT().Schedule(mock.Anything, scheduled.ID, now).Return(nil)
+	suite.mockSubscriptions.EXPECT().RecordDelivery(mock.Anything, now).Return(nil)
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(2, sent)
+	suite.mockSchedule.AssertNumberOfCalls(suite.T(), "Backfill", 1)
+	suite.mockSchedule.AssertCalled(suite.T(), "WordFor", "2025-08-05")
+	suite.mockSchedule.AssertCalled(suite.T(), "WordFor", "2025-08-06")
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_ScheduledWordGoesOutOnItsDate() {
+	// Given
+	now := time.Date(2025, 8, 6, 12, 0, 0, 0, time.UTC)
+	sub := subscriptionFixture("user@example.com")
+	scheduled := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity", Status: word.StatusScheduled}
+
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return([]*subscription.Subscription{sub}, nil)
+	suite.mockSchedule.EXPECT().Backfill("2025-08-06").Return(0, nil)
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(sub.ID, time.Time{}).Return(nil, nil)
+	suite.mockSubscriptions.EXPECT().ClaimDelivery(sub, now).Return(nil)
+	suite.mockSchedule.EXPECT().WordFor("2025-08-06").Return(scheduled, nil) // 21:00 in Seoul
+	suite.mockSubscriptions.EXPECT().PreferencesURL(sub.ID.Hex()).Return("", nil)
+	suite.mockReviews.EXPECT().DueReviews(sub.ID, now).Return(nil, nil)
+	suite.mockMailSender.EXPECT().SendDailyWordEmail(mock.Anything, scheduled, mock.Anything).Return(nil)
+	suite.mockRepo.EXPECT().SaveDelivery(mock.Anything).Return(&Delivery{}, nil)
+	suite.mockWords.EXPECT().MarkDelivered(scheduled, now).Return(nil)
+	suite.mockReviews.EXPECT().Schedule(sub.ID, scheduled.ID, now).Return(nil)
+	suite.mockSubscriptions.EXPECT().RecordDelivery(sub, now).Return(nil)
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(1, sent)
+	suite.mockWords.AssertNotCalled(suite.T(), "NextWord", mock.Anything)
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_ScheduledWordAlreadyReceivedFallsBackWithoutReservedWords() {
+	// Given
+	now := time.Date(2025, 8, 6, 12, 0, 0, 0, time.UTC)
+	sub := subscriptionFixture("user@example.com")
+	scheduled := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity"}
+	reserved := primitive.NewObjectID()
+	nextWord := &word.Word{ID: primitive.NewObjectID(), Text: "ephemeral"}
+
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return([]*subscription.Subscription{sub}, nil)
+	suite.mockSchedule.EXPECT().Backfill("2025-08-06").Return(0, nil)
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(sub.ID, time.Time{}).Return([]primitive.ObjectID{scheduled.ID}, nil)
+	suite.mockSubscriptions.EXPECT().ClaimDelivery(sub, now).Return(nil)
+	suite.mockSchedule.EXPECT().WordFor("2025-08-06").Return(scheduled, nil)
+	suite.mockSchedule.EXPECT().ReservedWordIDs("2025-08-06").Return([]primitive.ObjectID{reserved}, nil)
+	suite.mockWords.EXPECT().NextWord(&word.Selection{ExcludeIDs: []primitive.ObjectID{scheduled.ID, reserved}}).Return(nextWord, nil)
+	suite.mockSubscriptions.EXPECT().PreferencesURL(sub.ID.Hex()).Return("", nil)
+	suite.mockReviews.EXPECT().DueReviews(sub.ID, now).Return(nil, nil)
+	suite.mockMailSender.EXPECT().SendDailyWordEmail(mock.Anything, nextWord, mock.Anything).Return(nil)
+	suite.mockRepo.EXPECT().SaveDelivery(mock.Anything).Return(&Delivery{}, nil)
+	suite.mockWords.EXPECT().MarkDelivered(nextWord, now).Return(nil)
+	suite.mockReviews.EXPECT().Schedule(sub.ID, nextWord.ID, now).Return(nil)
+	suite.mockSubscriptions.EXPECT().RecordDelivery(sub, now).Return(nil)
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(1, sent)
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_FailedSendIsRecordedAsFailed() {
+	// Given
+	suite.emptySchedule()
+	now := time.Now()
+	failing := subscriptionFixture("failing@example.com")
+	nextWord := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity"}
+
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return([]*subscription.Subscription{failing}, nil)
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(failing.ID, time.Time{}).Return(nil, nil)
+	suite.mockSubscriptions.EXPECT().ClaimDelivery(failing, now).Return(nil)
+	suite.mockWords.EXPECT().NextWord(mock.Anything).Return(nextWord, nil)
+	suite.mockSubscriptions.EXPECT().PreferencesURL(failing.ID.Hex()).Return("", nil)
+	suite.mockReviews.EXPECT().DueReviews(failing.ID, now).Return(nil, nil)
+	suite.mockMailSender.EXPECT().SendDailyWordEmail(mock.Anything, nextWord, mock.Anything).Return(errors.New("smtp unavailable"))
+	suite.mockRepo.EXPECT().SaveDelivery(mock.MatchedBy(func(delivery *Delivery) bool {
+		return delivery.Status == StatusFailed && delivery.Error == "smtp unavailable" && delivery.WordID == nextWord.ID
+	})).Return(&Delivery{}, nil)
+	suite.mockSubscriptions.EXPECT().RecordFailedDelivery(failing, now).Return(nil)
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(0, sent)
+	suite.mockSubscriptions.AssertNotCalled(suite.T(), "RecordDelivery", mock.Anything, mock.Anything)
+	suite.mockWords.AssertNotCalled(suite.T(), "MarkDelivered", mock.Anything, mock.Anything)
+	suite.mockReviews.AssertNotCalled(suite.T(), "Schedule", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_BookkeepingFailureAfterSendKeepsTheDayClaimed() {
+	// Given
+	suite.emptySchedule()
+	now := time.Now()
+	sub := subscriptionFixture("user@example.com")
+	nextWord := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity"}
+
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return([]*subscription.Subscription{sub}, nil)
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(sub.ID, time.Time{}).Return(nil, nil)
+	suite.mockWords.EXPECT().NextWord(mock.Anything).Return(nextWord, nil)
+	suite.mockSubscriptions.EXPECT().ClaimDelivery(sub, now).Return(nil)
+	suite.mockSubscriptions.EXPECT().PreferencesURL(sub.ID.Hex()).Return("", nil)
+	suite.mockReviews.EXPECT().DueReviews(sub.ID, now).Return(nil, nil)
+	suite.mockMailSender.EXPECT().SendDailyWordEmail(mock.Anything, nextWord, mock.Anything).Return(nil)
+	suite.mockRepo.EXPECT().SaveDelivery(mock.Anything).Return(&Delivery{}, nil)
+	suite.mockWords.EXPECT().MarkDelivered(nextWord, now).Return(errors.New("database unavailable"))
+	suite.mockReviews.EXPECT().Schedule(sub.ID, nextWord.ID, now).Return(nil)
+	suite.mockSubscriptions.EXPECT().RecordDelivery(sub, now).Return(errors.New("database unavailable"))
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(1, sent, "Expected the email to count as sent")
+	suite.mockSubscriptions.AssertNotCalled(suite.T(), "RecordFailedDelivery", mock.Anything, mock.Anything)
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_ClaimedByAnotherRun() {
+	// Given
+	suite.emptySchedule()
+	now := time.Now()
+	sub := subscriptionFixture("user@example.com")
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return([]*subscription.Subscription{sub}, nil)
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(sub.ID, time.Time{}).Return(nil, nil)
+	suite.mockWords.EXPECT().NextWord(mock.Anything).Return(&word.Word{ID: primitive.NewObjectID()}, nil)
+	suite.mockSubscriptions.EXPECT().ClaimDelivery(sub, now).Return(subscription.ErrDeliveryClaimed)
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(0, sent)
+	suite.mockMailSender.AssertNotCalled(suite.T(), "SendDailyWordEmail", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_NothingDue() {
+	// Given
+	now := time.Now()
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return(nil, nil)
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(0, sent)
+	suite.mockWords.AssertNotCalled(suite.T(), "NextWord", mock.Anything)
+}
+
+func (suite *DeliveryServiceTestSuite) TestDeliverDue_SubscriptionReceivedEveryWord() {
+	// Given
+	suite.emptySchedule()
+	now := time.Now()
+	caughtUp := subscriptionFixture("user@example.com")
+	suite.mockSubscriptions.EXPECT().FindDueSubscriptions(now).Return([]*subscription.Subscription{caughtUp}, nil)
+	suite.mockRepo.EXPECT().FindDeliveredWordIDs(caughtUp.ID, time.Time{}).Return([]primitive.ObjectID{primitive.NewObjectID()}, nil)
+	suite.mockWords.EXPECT().NextWord(mock.Anything).Return(nil, word.ErrNoWordAvailable)
+
+	// When
+	sent, err := suite.service.DeliverDue(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(0, sent)
+	suite.mockMailSender.AssertNotCalled(suite.T(), "SendDailyWordEmail", mock.Anything, mock.Anything, mock.Anything)
+	suite.mockRepo.AssertNotCalled(suite.T(), "SaveDelivery", mock.Anything)
+}
@@ -0,0 +1,8 @@
+package dictionary
+
+import "errors"
+
+var (
+	ErrEntryNotFound = errors.New("word not found in the dictionary")
+	ErrWordNotFound  = errors.New("word not found")
+)
@@ -0,0 +1,95 @@
+package dictionary
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultFreeDictionaryURL is the public Free Dictionary API, https://dictionaryapi.dev.
+const DefaultFreeDictionaryURL = "https://api.dictionaryapi.dev/api/v2/entries/en"
+
+// FreeDictionary looks words up in an HTTP API that answers like the Free Dictionary API: GET {baseURL}/{word}
+// returns a list of entries, or 404 when the word is unknown.
+type FreeDictionary struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewFreeDictionary(baseURL string) *FreeDictionary {
+	return &FreeDictionary{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type freeDictionaryEntry struct {
+	Word      string `json:"word"`
+	Phonetic  string `json:"phonetic"`
+	Phonetics []struct {
+		Text  string `json:"text"`
+		Audio string `json:"audio"`
+	} `json:"phonetics"`
+	Meanings []struct {
+		PartOfSpeech string `json:"partOfSpeech"`
+		Definitions  []struct {
+			Definition string   `json:"definition"`
+			Example    string   `json:"example"`
+			Synonyms   []string `json:"synonyms"`
+		} `json:"definitions"`
+		Synonyms []string `json:"synonyms"`
+	} `json:"meanings"`
+}
+
+func (d *FreeDictionary) Lookup(text string) (*Entry, error) {
+	resp, err := d.client.Get(d.baseURL + "/" + url.PathEscape(strings.TrimSpace(text)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up %q: %w", text, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrEntryNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to look up %q: dictionary answered %s", text, resp.Status)
+	}
+
+	var found []freeDictionaryEntry
+	if err := json.NewDecoder(resp.Body).Decode(&found); err != nil {
+		return nil, fmt.Errorf("failed to decode dictionary entry of %q: %w", text, err)
+	}
+	if len(found) == 0 {
+		return nil, ErrEntryNotFound
+	}
+
+	entry := &Entry{Text: found[0].Word, Source: "Free Dictionary"}
+	for _, result := range found {
+		if entry.IPA == "" {
+			entry.IPA = result.Phonetic
+		}
+		for _, phonetic := range result.Phonetics {
+			if entry.IPA == "" {
+				entry.IPA = phonetic.Text
+			}
+			if entry.AudioURL == "" {
+				entry.AudioURL = phonetic.Audio
+			}
+		}
+		for _, meaning := range result.Meanings {
+			for _, definition := range meaning.Definitions {
+				entry.Definitions = append(entry.Definitions, Definition{
+					PartOfSpeech: meaning.PartOfSpeech,
+					Definition:   definition.Definition,
+					Example:      definition.Example,
+				})
+				entry.Synonyms = appendUnique(entry.Synonyms, definition.Synonyms...)
+			}
+			entry.Synonyms = appendUnique(entry.Synonyms, meaning.Synonyms...)
+		}
+	}
+	return entry, nil
+}
@@ -0,0 +1,73 @@
+package dictionary
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const serendipityResponse = `[{
+	"word": "serendipity",
+	"phonetic": "/ˌsɛɹənˈdɪpɪti/",
+	"phonetics": [{"text": "/ˌsɛɹənˈdɪpɪti/", "audio": ""}, {"text": "", "audio": "https://api.dictionaryapi.dev/media/pronunciations/en/serendipity-us.mp3"}],
+	"meanings": [{
+		"partOfSpeech": "noun",
+		"definitions": [
+			{"definition": "An unsought, unintended, and/or unexpected discovery made by happy accident.", "example": "Meeting her there was pure serendipity.", "synonyms": ["chance"]},
+			{"definition": "The faculty of making such discoveries.", "synonyms": []}
+		],
+		"synonyms": ["luck", "Chance"]
+	}]
+}]`
+
+func newFreeDictionaryStandIn(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/entries/en/serendipity":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(serendipityResponse))
+		case "/entries/en/unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"title": "No Definitions Found"}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFreeDictionary_Lookup(t *testing.T) {
+	server := newFreeDictionaryStandIn(t)
+	freeDictionary := NewFreeDictionary(server.URL + "/entries/en/")
+
+	t.Run("Found", func(t *testing.T) {
+		entry, err := freeDictionary.Lookup("serendipity")
+
+		require.NoError(t, err)
+		assert.Equal(t, "serendipity", entry.Text)
+		assert.Equal(t, "Free Dictionary", entry.Source)
+		assert.Equal(t, "/ˌsɛɹənˈdɪpɪti/", entry.IPA)
+		assert.Equal(t, "https://api.dictionaryapi.dev/media/pronunciations/en/serendipity-us.mp3", entry.AudioURL)
+		assert.Equal(t, []Definition{
+			{PartOfSpeech: "noun", Definition: "An unsought, unintended, and/or unexpected discovery made by happy accident.", Example: "Meeting her there was pure serendipity."},
+			{PartOfSpeech: "noun", Definition: "The faculty of making such discoveries."},
+		}, entry.Definitions)
+		assert.Equal(t, []string{"chance", "luck"}, entry.Synonyms)
+	})
+
+	t.Run("Unknown Word", func(t *testing.T) {
+		_, err := freeDictionary.Lookup("wordropish")
+		assert.ErrorIs(t, err, ErrEntryNotFound)
+	})
+
+	t.Run("Dictionary Down", func(t *testing.T) {
+		_, err := freeDictionary.Lookup("unavailable")
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrEntryNotFound)
+	})
+}
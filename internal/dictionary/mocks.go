@@ -0,0 +1,188 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package dictionary
+
+import (
+	"github.com/Go-roro/wordrop/internal/word"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockProvider creates a new instance of MockProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProvider {
+	mock := &MockProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProvider is an autogenerated mock type for the Provider type
+type MockProvider struct {
+	mock.Mock
+}
+
+type MockProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProvider) EXPECT() *MockProvider_Expecter {
+	return &MockProvider_Expecter{mock: &_m.Mock}
+}
+
+// Lookup provides a mock function for the type MockProvider
+func (_mock *MockProvider) Lookup(text string) (*Entry, error) {
+	ret := _mock.Called(text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lookup")
+	}
+
+	var r0 *Entry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*Entry, error)); ok {
+		return returnFunc(text)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *Entry); ok {
+		r0 = returnFunc(text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Entry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(text)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProvider_Lookup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lookup'
+type MockProvider_Lookup_Call struct {
+	*mock.Call
+}
+
+// Lookup is a helper method to define mock.On call
+//   - text string
+func (_e *MockProvider_Expecter) Lookup(text interface{}) *MockProvider_Lookup_Call {
+	return &MockProvider_Lookup_Call{Call: _e.mock.On("Lookup", text)}
+}
+
+func (_c *MockProvider_Lookup_Call) Run(run func(text string)) *MockProvider_Lookup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProvider_Lookup_Call) Return(entry *Entry, err error) *MockProvider_Lookup_Call {
+	_c.Call.Return(entry, err)
+	return _c
+}
+
+func (_c *MockProvider_Lookup_Call) RunAndReturn(run func(text string) (*Entry, error)) *MockProvider_Lookup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWords creates a new instance of MockWords. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWords(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWords {
+	mock := &MockWords{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWords is an autogenerated mock type for the Words type
+type MockWords struct {
+	mock.Mock
+}
+
+type MockWords_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWords) EXPECT() *MockWords_Expecter {
+	return &MockWords_Expecter{mock: &_m.Mock}
+}
+
+// FindWord provides a mock function for the type MockWords
+func (_mock *MockWords) FindWord(id string) (*word.Word, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWord")
+	}
+
+	var r0 *word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*word.Word, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *word.Word); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_FindWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWord'
+type MockWords_FindWord_Call struct {
+	*mock.Call
+}
+
+// FindWord is a helper method to define mock.On call
+//   - id string
+func (_e *MockWords_Expecter) FindWord(id interface{}) *MockWords_FindWord_Call {
+	return &MockWords_FindWord_Call{Call: _e.mock.On("FindWord", id)}
+}
+
+func (_c *MockWords_FindWord_Call) Run(run func(id string)) *MockWords_FindWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) Return(word1 *word.Word, err error) *MockWords_FindWord_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) RunAndReturn(run func(id string) (*word.Word, error)) *MockWords_FindWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
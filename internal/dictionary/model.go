@@ -0,0 +1,18 @@
+package dictionary
+
+// Entry is what a dictionary knows about a word.
+type Entry struct {
+	Text        string
+	Definitions []Definition
+	Synonyms    []string
+	IPA         string
+	AudioURL    string
+	// Source names the dictionary the entry came from.
+	Source string
+}
+
+type Definition struct {
+	PartOfSpeech string `json:"part_of_speech,omitempty"`
+	Definition   string `json:"definition"`
+	Example      string `json:"example,omitempty"`
+}
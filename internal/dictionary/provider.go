@@ -0,0 +1,44 @@
+package dictionary
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// Provider looks words up in a dictionary. Lookup returns ErrEntryNotFound if the dictionary does not know the word.
+type Provider interface {
+	Lookup(text string) (*Entry, error)
+}
+
+// Chain asks each provider in turn and returns the first entry found, e.g. an offline dictionary before an online one.
+type Chain []Provider
+
+func (c Chain) Lookup(text string) (*Entry, error) {
+	var errs []error
+	for _, provider := range c {
+		entry, err := provider.Lookup(text)
+		if err == nil {
+			return entry, nil
+		}
+		if !errors.Is(err, ErrEntryNotFound) {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return nil, ErrEntryNotFound
+}
+
+// appendUnique appends the values not already in list, ignoring case.
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || slices.ContainsFunc(list, func(existing string) bool { return strings.EqualFold(existing, value) }) {
+			continue
+		}
+		list = append(list, value)
+	}
+	return list
+}
@@ -0,0 +1,138 @@
+package dictionary
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+// maxProposedExamples caps how many dictionary examples are proposed at once.
+const maxProposedExamples = 3
+
+type Words interface {
+	FindWord(id string) (*word.Word, error)
+}
+
+type Service struct {
+	provider Provider
+	words    Words
+}
+
+func NewDictionaryService(provider Provider, words Words) *Service {
+	return &Service{
+		provider: provider,
+		words:    words,
+	}
+}
+
+// Proposal is what a dictionary suggests adding to a word. Nothing is saved until an editor accepts it by
+// sending Patch to PATCH /words/{id} with If-Match set to Version, after editing it as they see fit.
+type Proposal struct {
+	WordID  string `json:"word_id"`
+	Version int    `json:"version"`
+	Source  string `json:"source"`
+	// EnglishMeaning is only proposed for words that have none.
+	EnglishMeaning string `json:"english_meaning,omitempty"`
+	// IPA and AudioURL are only proposed for words that have none.
+	IPA      string `json:"ipa,omitempty"`
+	AudioURL string `json:"audio_url,omitempty"`
+	// Synonyms and Examples are the ones the word does not have yet.
+	Synonyms []string       `json:"synonyms,omitempty"`
+	Examples []word.Example `json:"examples,omitempty"`
+	// Definitions lists everything the dictionary knows, for the editor to pick from.
+	Definitions []Definition `json:"definitions"`
+	// Patch is a JSON Merge Patch adding the proposal to the word.
+	Patch map[string]any `json:"patch"`
+}
+
+// Propose looks a word up in the dictionary and proposes the meaning, pronunciation, synonyms and examples it is missing.
+func (s *Service) Propose(wordID string) (*Proposal, error) {
+	found, err := s.words.FindWord(wordID)
+	if err != nil || found.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", ErrWordNotFound, wordID)
+	}
+
+	entry, err := s.provider.Lookup(found.Text)
+	if err != nil {
+		return nil, err
+	}
+	return propose(found, entry), nil
+}
+
+func propose(w *word.Word, entry *Entry) *Proposal {
+	proposal := &Proposal{
+		WordID:      w.ID.Hex(),
+		Version:     w.Version,
+		Source:      entry.Source,
+		Definitions: entry.Definitions,
+		Patch:       map[string]any{},
+	}
+
+	// Meanings, synonyms and examples are proposed as changes to the word's senses, so accepting
+	// them keeps the senses the editor wrote. What has no better place goes to the first sense.
+	senses := slices.Clone(w.Senses)
+	if len(senses) == 0 {
+		senses = word.SensesFromMeanings(w.EnglishMeaning, w.KoreanMeanings, w.PartsOfSpeech, w.Examples, w.Synonyms)
+	}
+	if len(senses) == 0 {
+		senses = []word.Sense{{}}
+	}
+	sensesChanged := false
+
+	if strings.TrimSpace(w.EnglishMeaning) == "" && len(entry.Definitions) > 0 {
+		proposal.EnglishMeaning = entry.Definitions[0].Definition
+		senses[0].EnglishDefinition = proposal.EnglishMeaning
+		sensesChanged = true
+	}
+
+	if w.IPA == "" && entry.IPA != "" {
+		proposal.IPA = word.NormalizeIPA(entry.IPA)
+		proposal.Patch["ipa"] = proposal.IPA
+	}
+	if w.AudioURL == "" && entry.AudioURL != "" {
+		proposal.AudioURL = entry.AudioURL
+		proposal.Patch["audio_url"] = proposal.AudioURL
+	}
+
+	if synonyms := appendUnique(slices.Clone(w.Synonyms), entry.Synonyms...); len(synonyms) > len(w.Synonyms) {
+		proposal.Synonyms = synonyms[len(w.Synonyms):]
+		senses[0].Synonyms = append(slices.Clone(senses[0].Synonyms), proposal.Synonyms...)
+		sensesChanged = true
+	}
+
+	for _, definition := range entry.Definitions {
+		if len(proposal.Examples) == maxProposedExamples {
+			break
+		}
+		if definition.Example == "" || hasExample(w.Examples, definition.Example) || hasExample(proposal.Examples, definition.Example) {
+			continue
+		}
+		example := word.Example{ExampleText: definition.Example}
+		proposal.Examples = append(proposal.Examples, example)
+		index := senseFor(senses, definition.PartOfSpeech)
+		senses[index].Examples = append(slices.Clone(senses[index].Examples), example)
+		sensesChanged = true
+	}
+
+	if sensesChanged {
+		proposal.Patch["senses"] = senses
+	}
+	return proposal
+}
+
+// senseFor finds the sense a dictionary definition with the given part of speech belongs to, or the first sense.
+func senseFor(senses []word.Sense, partOfSpeech string) int {
+	parsed, err := word.ParsePartOfSpeech(partOfSpeech)
+	if err != nil || parsed == "" {
+		return 0
+	}
+	return max(slices.IndexFunc(senses, func(sense word.Sense) bool { return sense.PartOfSpeech == parsed }), 0)
+}
+
+func hasExample(examples []word.Example, text string) bool {
+	return slices.ContainsFunc(examples, func(example word.Example) bool {
+		return strings.EqualFold(example.ExampleText, text)
+	})
+}
@@ -0,0 +1,143 @@
+package dictionary
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DictionaryServiceTestSuite struct {
+	suite.Suite
+	mockProvider *MockProvider
+	mockWords    *MockWords
+	service      *Service
+}
+
+func (suite *DictionaryServiceTestSuite) SetupTest() {
+	suite.mockProvider = new(MockProvider)
+	suite.mockWords = new(MockWords)
+	suite.service = NewDictionaryService(suite.mockProvider, suite.mockWords)
+}
+
+func TestDictionaryServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(DictionaryServiceTestSuite))
+}
+
+func (suite *DictionaryServiceTestSuite) TestPropose_OnlyWhatIsMissing() {
+	// Given
+	examples := []word.Example{{ExampleText: "Meeting her there was pure serendipity.", KoreanText: "그녀를 만난 건 순전한 우연이었다."}}
+	existing := &word.Word{
+		ID:       primitive.NewObjectID(),
+		Text:     "serendipity",
+		Synonyms: []string{"Luck"},
+		Examples: examples,
+		Senses: []word.Sense{
+			{PartOfSpeech: word.Adjective, KoreanGlosses: []string{"뜻밖의"}},
+			{PartOfSpeech: word.Noun, KoreanGlosses: []string{"우연한 발견"}, Examples: examples, Synonyms: []string{"Luck"}},
+		},
+		Version: 3,
+	}
+	suite.mockWords.EXPECT().FindWord(existing.ID.Hex()).Return(existing, nil)
+	suite.mockProvider.EXPECT().Lookup("serendipity").Return(&Entry{
+		Text:   "serendipity",
+		Source: "WordNet",
+		Definitions: []Definition{
+			{PartOfSpeech: "noun", Definition: "good luck in making unexpected discoveries", Example: "Meeting her there was pure serendipity."},
+			{PartOfSpeech: "noun", Definition: "a happy accident", Example: "It was serendipity that we met."},
+		},
+		Synonyms: []string{"luck", "fluke"},
+		IPA:      "/ˌsɛɹənˈdɪpɪti/",
+	}, nil)
+
+	// When
+	proposal, err := suite.service.Propose(existing.ID.Hex())
+
+	// Then
+	suite.NoError(err, "Expected no error when proposing enrichment")
+	suite.Equal(3, proposal.Version)
+	suite.Equal("good luck in making unexpected discoveries", proposal.EnglishMeaning)
+	suite.Equal("ˌsɛɹənˈdɪpɪti", proposal.Patch["ipa"])
+	suite.Empty(proposal.AudioURL)
+	suite.Equal([]string{"fluke"}, proposal.Synonyms)
+	suite.Equal([]word.Example{{ExampleText: "It was serendipity that we met."}}, proposal.Examples)
+	senses := proposal.Patch["senses"].([]word.Sense)
+	suite.Equal("good luck in making unexpected discoveries", senses[0].EnglishDefinition)
+	suite.Equal([]string{"fluke"}, senses[0].Synonyms)
+	suite.Equal([]string{"우연한 발견"}, senses[1].KoreanGlosses, "Expected the patch to keep the senses")
+	suite.Len(senses[1].Examples, 2, "Expected the example to go to the sense with its part of speech")
+	suite.Equal([]word.Example{examples[0]}, existing.Senses[1].Examples, "Expected the word to be left as it is")
+}
+
+func (suite *DictionaryServiceTestSuite) TestPropose_KeepsExistingMeaning() {
+	// Given
+	existing := &word.Word{ID: primitive.NewObjectID(), Text: "test", EnglishMeaning: "a trial"}
+	suite.mockWords.EXPECT().FindWord(existing.ID.Hex()).Return(existing, nil)
+	suite.mockProvider.EXPECT().Lookup("test").Return(&Entry{Definitions: []Definition{{Definition: "an exam"}}}, nil)
+
+	// When
+	proposal, err := suite.service.Propose(existing.ID.Hex())
+
+	// Then
+	suite.NoError(err)
+	suite.Empty(proposal.EnglishMeaning)
+	suite.Empty(proposal.Patch, "Expected nothing to propose")
+}
+
+func (suite *DictionaryServiceTestSuite) TestPropose_BuildsSensesForWordsWithout() {
+	// Given
+	existing := &word.Word{ID: primitive.NewObjectID(), Text: "test", KoreanMeanings: []string{"시험"}, PartsOfSpeech: []word.PartOfSpeech{word.Noun}}
+	suite.mockWords.EXPECT().FindWord(existing.ID.Hex()).Return(existing, nil)
+	suite.mockProvider.EXPECT().Lookup("test").Return(&Entry{Definitions: []Definition{{Definition: "an exam"}}}, nil)
+
+	// When
+	proposal, err := suite.service.Propose(existing.ID.Hex())
+
+	// Then
+	suite.NoError(err)
+	suite.Equal([]word.Sense{{PartOfSpeech: word.Noun, EnglishDefinition: "an exam", KoreanGlosses: []string{"시험"}}}, proposal.Patch["senses"])
+}
+
+func (suite *DictionaryServiceTestSuite) TestPropose_Failures() {
+	deleted := &word.Word{ID: primitive.NewObjectID(), Text: "deleted", DeletedAt: time.Now()}
+	unknown := &word.Word{ID: primitive.NewObjectID(), Text: "wordropish"}
+	suite.mockWords.EXPECT().FindWord("missing").Return(nil, errors.New("not found"))
+	suite.mockWords.EXPECT().FindWord(deleted.ID.Hex()).Return(deleted, nil)
+	suite.mockWords.EXPECT().FindWord(unknown.ID.Hex()).Return(unknown, nil)
+	suite.mockProvider.EXPECT().Lookup("wordropish").Return(nil, ErrEntryNotFound)
+
+	tests := []struct {
+		name    string
+		wordID  string
+		wantErr error
+	}{
+		{name: "Missing Word", wordID: "missing", wantErr: ErrWordNotFound},
+		{name: "Deleted Word", wordID: deleted.ID.Hex(), wantErr: ErrWordNotFound},
+		{name: "Unknown To The Dictionary", wordID: unknown.ID.Hex(), wantErr: ErrEntryNotFound},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.service.Propose(tt.wordID)
+			suite.ErrorIs(err, tt.wantErr)
+		})
+	}
+}
+
+func (suite *DictionaryServiceTestSuite) TestChain_FallsBack() {
+	// Given
+	offline := new(MockProvider)
+	online := new(MockProvider)
+	offline.EXPECT().Lookup("serendipity").Return(nil, ErrEntryNotFound)
+	online.EXPECT().Lookup("serendipity").Return(&Entry{Text: "serendipity", Source: "Free Dictionary"}, nil)
+
+	// When
+	entry, err := Chain{offline, online}.Lookup("serendipity")
+
+	// Then
+	suite.NoError(err)
+	suite.Equal("Free Dictionary", entry.Source)
+}
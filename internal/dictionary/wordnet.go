@@ -0,0 +1,189 @@
+package dictionary
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// wordNetFiles maps the suffix of WordNet's index.* and data.* files to the part of speech they hold.
+var wordNetFiles = []struct {
+	suffix       string
+	partOfSpeech string
+}{
+	{"noun", "noun"},
+	{"verb", "verb"},
+	{"adj", "adjective"},
+	{"adv", "adverb"},
+}
+
+// WordNet looks words up in a local copy of the WordNet database files, for editors working offline.
+type WordNet struct {
+	// senses maps a lemma to its synsets, most common sense first.
+	senses map[string][]*synset
+}
+
+type synset struct {
+	partOfSpeech string
+	words        []string
+	gloss        string
+}
+
+// NewWordNet loads the index.* and data.* files found in dir. Parts of speech whose files are missing are skipped.
+func NewWordNet(dir string) (*WordNet, error) {
+	wordNet := &WordNet{senses: map[string][]*synset{}}
+	loaded := 0
+	for _, file := range wordNetFiles {
+		var synsets map[string]*synset
+		err := readWordNetFile(filepath.Join(dir, "data."+file.suffix), func(r io.Reader) (err error) {
+			synsets, err = readWordNetData(r, file.partOfSpeech)
+			return err
+		})
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = readWordNetFile(filepath.Join(dir, "index."+file.suffix), func(r io.Reader) error {
+			return wordNet.readIndex(r, synsets)
+		})
+		if err != nil {
+			return nil, err
+		}
+		loaded++
+	}
+
+	if loaded == 0 {
+		return nil, fmt.Errorf("no WordNet files found in %s", dir)
+	}
+	return wordNet, nil
+}
+
+func readWordNetFile(path string, read func(r io.Reader) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := read(file); err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return nil
+}
+
+// readWordNetData reads a data.* file into its synsets by byte offset. A line reads
+// "offset lex_filenum ss_type w_cnt word lex_id [word lex_id...] p_cnt [pointers...] | gloss".
+func readWordNetData(r io.Reader, partOfSpeech string) (map[string]*synset, error) {
+	synsets := map[string]*synset{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, " ") {
+			continue // the license header is indented
+		}
+
+		fields, gloss, _ := strings.Cut(line, " | ")
+		parts := strings.Fields(fields)
+		if len(parts) < 4 {
+			return nil, fmt.Errorf("malformed synset %q", line)
+		}
+		var wordCount int
+		if _, err := fmt.Sscanf(parts[3], "%x", &wordCount); err != nil || len(parts) < 4+2*wordCount {
+			return nil, fmt.Errorf("malformed synset %q", line)
+		}
+
+		words := make([]string, 0, wordCount)
+		for i := 0; i < wordCount; i++ {
+			words = append(words, lemmaText(parts[4+2*i]))
+		}
+		synsets[parts[0]] = &synset{partOfSpeech: partOfSpeech, words: words, gloss: strings.TrimSpace(gloss)}
+	}
+	return synsets, scanner.Err()
+}
+
+// readIndex reads an index.* file, whose lines end with the offsets of a lemma's synsets, most common sense first.
+func (w *WordNet) readIndex(r io.Reader, synsets map[string]*synset) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, " ") {
+			continue
+		}
+
+		parts := strings.Fields(line)
+		if len(parts) < 4 {
+			return fmt.Errorf("malformed index line %q", line)
+		}
+		var senseCount int
+		if _, err := fmt.Sscanf(parts[2], "%d", &senseCount); err != nil || len(parts) < 4+senseCount {
+			return fmt.Errorf("malformed index line %q", line)
+		}
+
+		lemma := parts[0]
+		for _, offset := range parts[len(parts)-senseCount:] {
+			if found, ok := synsets[offset]; ok {
+				w.senses[lemma] = append(w.senses[lemma], found)
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+func (w *WordNet) Lookup(text string) (*Entry, error) {
+	lemma := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(text)), " ", "_")
+	senses := w.senses[lemma]
+	if len(senses) == 0 {
+		return nil, ErrEntryNotFound
+	}
+
+	entry := &Entry{Text: lemmaText(lemma), Source: "WordNet"}
+	for _, sense := range senses {
+		definition, examples := splitGloss(sense.gloss)
+		found := Definition{PartOfSpeech: sense.partOfSpeech, Definition: definition}
+		if len(examples) > 0 {
+			found.Example = examples[0]
+		}
+		entry.Definitions = append(entry.Definitions, found)
+
+		for _, synonym := range sense.words {
+			if !strings.EqualFold(synonym, entry.Text) {
+				entry.Synonyms = appendUnique(entry.Synonyms, synonym)
+			}
+		}
+	}
+	return entry, nil
+}
+
+// lemmaText turns a WordNet lemma such as "look_up" or "big(a)" into plain text.
+func lemmaText(lemma string) string {
+	if i := strings.IndexByte(lemma, '('); i > 0 {
+		lemma = lemma[:i]
+	}
+	return strings.ReplaceAll(lemma, "_", " ")
+}
+
+// splitGloss separates a gloss such as `a trial; "the test was positive"` into its definition and quoted examples.
+func splitGloss(gloss string) (string, []string) {
+	var definitions, examples []string
+	for _, part := range strings.Split(gloss, "; ") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, `"`) {
+			examples = append(examples, strings.Trim(part, `"`))
+			continue
+		}
+		if part != "" {
+			definitions = append(definitions, part)
+		}
+	}
+	return strings.Join(definitions, "; "), examples
+}
@@ -0,0 +1,56 @@
+package dictionary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordNet_Lookup(t *testing.T) {
+	wordNet, err := NewWordNet("testdata/wordnet")
+	require.NoError(t, err)
+
+	t.Run("Senses In Order", func(t *testing.T) {
+		entry, err := wordNet.Lookup("Test")
+
+		require.NoError(t, err)
+		assert.Equal(t, "WordNet", entry.Source)
+		assert.Equal(t, []Definition{
+			{
+				PartOfSpeech: "noun",
+				Definition:   "any standardized procedure for measuring sensitivity or memory or intelligence or aptitude or personality etc",
+				Example:      "the test was standardized on a large sample of students",
+			},
+			{
+				PartOfSpeech: "noun",
+				Definition:   "the act of testing something",
+				Example:      "in the experimental trials the amount of carbon was measured separately",
+			},
+			{
+				PartOfSpeech: "verb",
+				Definition:   "put to the test, as for its quality, or give experimental use to",
+				Example:      "This approach has been tried with good results",
+			},
+		}, entry.Definitions)
+		assert.Equal(t, []string{"mental test", "mental testing", "trial", "prove"}, entry.Synonyms)
+	})
+
+	t.Run("Multi-word Lemma", func(t *testing.T) {
+		entry, err := wordNet.Lookup("mental test")
+
+		require.NoError(t, err)
+		assert.Equal(t, "mental test", entry.Text)
+		assert.Equal(t, []string{"test", "mental testing"}, entry.Synonyms)
+	})
+
+	t.Run("Unknown Word", func(t *testing.T) {
+		_, err := wordNet.Lookup("serendipity")
+		assert.ErrorIs(t, err, ErrEntryNotFound)
+	})
+}
+
+func TestNewWordNet_NoFiles(t *testing.T) {
+	_, err := NewWordNet(t.TempDir())
+	assert.Error(t, err)
+}
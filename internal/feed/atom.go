@@ -0,0 +1,72 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Link      atomLink    `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Atom renders words, newest first, as an Atom 1.0 feed.
+func Atom(words []*word.Word, baseURL string) ([]byte, error) {
+	feed := atomFeed{
+		Xmlns:   atomNamespace,
+		ID:      "tag:wordrop,2025:feed",
+		Title:   feedTitle,
+		Updated: LastModified(words).UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: baseURL},
+			{Href: baseURL + "/feed.atom", Rel: "self"},
+		},
+		Author: atomAuthor{Name: "Wordrop"},
+	}
+
+	for _, w := range words {
+		feed.Entries = append(feed.Entries, atomEntry{
+			ID:        wordID(w),
+			Title:     w.Text,
+			Link:      atomLink{Href: wordURL(baseURL, w)},
+			Published: w.DeliveredAt.UTC().Format(time.RFC3339),
+			Updated:   entryUpdated(w).UTC().Format(time.RFC3339),
+			Content:   atomContent{Type: "html", Value: summaryHTML(w)},
+		})
+	}
+
+	return marshal(feed)
+}
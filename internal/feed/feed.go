@@ -0,0 +1,75 @@
+package feed
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+const (
+	feedTitle       = "Wordrop - 오늘의 단어"
+	feedDescription = "매일 한 방울씩 떨어지는 영어 단어"
+)
+
+// wordURL is the public page of a delivered word.
+func wordURL(baseURL string, w *word.Word) string {
+	return fmt.Sprintf("%s/public/words/%s", baseURL, url.PathEscape(w.Text))
+}
+
+// wordID is a permanent identifier of a word that survives edits to its text.
+func wordID(w *word.Word) string {
+	return fmt.Sprintf("tag:wordrop,2025:word/%s", w.ID.Hex())
+}
+
+// LastModified returns when the newest of words was delivered or edited, the zero time for no words.
+func LastModified(words []*word.Word) time.Time {
+	var latest time.Time
+	for _, w := range words {
+		latest = later(latest, entryUpdated(w))
+	}
+	return latest
+}
+
+func entryUpdated(w *word.Word) time.Time {
+	return later(w.DeliveredAt, w.UpdatedAt)
+}
+
+func later(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
+
+// summaryHTML renders the meanings and examples of a word as the HTML body of a feed entry.
+func summaryHTML(w *word.Word) string {
+	var b strings.Builder
+	if len(w.KoreanMeanings) > 0 {
+		fmt.Fprintf(&b, "<p><strong>%s</strong></p>", html.EscapeString(strings.Join(w.KoreanMeanings, ", ")))
+	}
+	if w.EnglishMeaning != "" {
+		fmt.Fprintf(&b, "<p>%s</p>", html.EscapeString(w.EnglishMeaning))
+	}
+	if w.Description != "" {
+		fmt.Fprintf(&b, "<p>%s</p>", html.EscapeString(w.Description))
+	}
+	if len(w.Examples) > 0 {
+		b.WriteString("<ul>")
+		for _, example := range w.Examples {
+			fmt.Fprintf(&b, "<li>%s", html.EscapeString(example.ExampleText))
+			if example.KoreanText != "" {
+				fmt.Fprintf(&b, "<br>%s", html.EscapeString(example.KoreanText))
+			}
+			b.WriteString("</li>")
+		}
+		b.WriteString("</ul>")
+	}
+	if len(w.Synonyms) > 0 {
+		fmt.Fprintf(&b, "<p>유의어: %s</p>", html.EscapeString(strings.Join(w.Synonyms, ", ")))
+	}
+	return b.String()
+}
@@ -0,0 +1,108 @@
+package feed
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const testBaseURL = "https://wordrop.example.com"
+
+func feedWords() []*word.Word {
+	return []*word.Word{
+		{
+			ID:             primitive.NewObjectID(),
+			Text:           "serendipity",
+			EnglishMeaning: "the occurrence of events by chance in a happy way",
+			KoreanMeanings: []string{"뜻밖의 행운", "우연한 발견"},
+			Examples:       []word.Example{{ExampleText: "It was <pure> serendipity.", KoreanText: "순전히 우연이었다."}},
+			DeliveredAt:    time.Date(2025, 8, 2, 8, 0, 0, 0, time.UTC),
+			UpdatedAt:      time.Date(2025, 8, 3, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			ID:             primitive.NewObjectID(),
+			Text:           "ad hoc",
+			KoreanMeanings: []string{"임시의"},
+			DeliveredAt:    time.Date(2025, 8, 1, 8, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+func TestRSS(t *testing.T) {
+	words := feedWords()
+
+	body, err := RSS(words, testBaseURL)
+	require.NoError(t, err)
+
+	var feed rssFeed
+	require.NoError(t, xml.Unmarshal(body, &feed), "Feed should be well-formed XML")
+	require.Len(t, feed.Channel.Items, 2)
+
+	item := feed.Channel.Items[0]
+	assert.Equal(t, "serendipity", item.Title)
+	assert.Equal(t, testBaseURL+"/public/words/serendipity", item.Link)
+	assert.Equal(t, "tag:wordrop,2025:word/"+words[0].ID.Hex(), item.GUID.Value)
+	assert.False(t, item.GUID.IsPermaLink)
+	assert.Equal(t, "Sat, 02 Aug 2025 08:00:00 +0000", item.PubDate)
+	assert.Contains(t, item.Description, "뜻밖의 행운, 우연한 발견")
+	assert.Contains(t, item.Description, "It was &lt;pure&gt; serendipity.")
+	assert.Contains(t, item.Description, "순전히 우연이었다.")
+	assert.Equal(t, testBaseURL+"/public/words/ad%20hoc", feed.Channel.Items[1].Link)
+	assert.Equal(t, "Sun, 03 Aug 2025 08:00:00 +0000", feed.Channel.LastBuildDate)
+}
+
+func TestAtom(t *testing.T) {
+	words := feedWords()
+
+	body, err := Atom(words, testBaseURL)
+	require.NoError(t, err)
+
+	var feed atomFeed
+	require.NoError(t, xml.Unmarshal(body, &feed), "Feed should be well-formed XML")
+	assert.Equal(t, "2025-08-03T08:00:00Z", feed.Updated)
+	require.Len(t, feed.Entries, 2)
+
+	entry := feed.Entries[0]
+	assert.Equal(t, "tag:wordrop,2025:word/"+words[0].ID.Hex(), entry.ID)
+	assert.Equal(t, "2025-08-02T08:00:00Z", entry.Published)
+	assert.Equal(t, "2025-08-03T08:00:00Z", entry.Updated)
+	assert.Equal(t, "html", entry.Content.Type)
+	assert.Contains(t, entry.Content.Value, "<li>It was &lt;pure&gt; serendipity.<br>순전히 우연이었다.</li>")
+}
+
+func TestICS(t *testing.T) {
+	words := feedWords()
+	seoul := time.FixedZone("KST", 9*60*60)
+	words[0].Description = "A long description that is not part of the calendar entry."
+	words[0].KoreanMeanings = []string{"뜻밖의 행운; 좋은 일", "우연한 발견이라는 아주 긴 설명으로 한 줄을 넘기는 뜻"}
+
+	body := string(ICS([]Event{{Word: words[0], Day: words[0].DeliveredAt.In(seoul)}}, testBaseURL))
+
+	assert.True(t, strings.HasPrefix(body, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(body, "END:VCALENDAR\r\n"))
+	assert.Contains(t, body, "UID:"+words[0].ID.Hex()+"@wordrop\r\n")
+	assert.Contains(t, body, "DTSTART;VALUE=DATE:20250802\r\n")
+	assert.Contains(t, body, "DTEND;VALUE=DATE:20250803\r\n")
+	assert.Contains(t, body, "DTSTAMP:20250803T080000Z\r\n")
+	assert.Contains(t, body, "SUMMARY:serendipity\r\n")
+
+	for _, line := range strings.Split(strings.TrimSuffix(body, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), maxLineOctets, "Lines should be folded at 75 octets")
+		assert.True(t, utf8.ValidString(line), "Folding should not split a multi-byte character")
+	}
+
+	unfolded := strings.ReplaceAll(body, "\r\n ", "")
+	assert.Contains(t, unfolded, `DESCRIPTION:뜻밖의 행운\; 좋은 일\, 우연한 발견이라는 아주 긴 설명으로 한 줄을 넘기는 뜻\n`)
+	assert.Contains(t, unfolded, `\n- It was <pure> serendipity.\n  순전히 우연이었다.`)
+}
+
+func TestEscapeText(t *testing.T) {
+	assert.Equal(t, `a\\b\;c\,d\ne`, escapeText("a\\b;c,d\ne"))
+}
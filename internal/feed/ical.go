@@ -0,0 +1,103 @@
+package feed
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+// maxLineOctets is the longest content line RFC 5545 allows before it has to be folded.
+const maxLineOctets = 75
+
+// Event places a word on the calendar day it is, or was, delivered.
+type Event struct {
+	Word *word.Word
+	// Day is the delivery day in the calendar's time zone; only its date is used.
+	Day time.Time
+}
+
+// ICS renders events as an iCalendar (RFC 5545) feed with one all-day event per word.
+// Each event's UID is derived from the word ID, so calendar apps update rather than duplicate it on refresh.
+func ICS(events []Event, baseURL string) []byte {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//Wordrop//Daily Words//KO")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	writeLine(&b, "X-WR-CALNAME:"+escapeText(feedTitle))
+
+	for _, event := range events {
+		w := event.Word
+		stamp := entryUpdated(w)
+		if stamp.IsZero() {
+			stamp = w.CreatedAt
+		}
+
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, fmt.Sprintf("UID:%s@wordrop", w.ID.Hex()))
+		writeLine(&b, "DTSTAMP:"+stamp.UTC().Format("20060102T150405Z"))
+		writeLine(&b, "DTSTART;VALUE=DATE:"+event.Day.Format("20060102"))
+		writeLine(&b, "DTEND;VALUE=DATE:"+event.Day.AddDate(0, 0, 1).Format("20060102"))
+		writeLine(&b, "SUMMARY:"+escapeText(w.Text))
+		writeLine(&b, "DESCRIPTION:"+escapeText(summaryText(w)))
+		writeLine(&b, "URL:"+wordURL(baseURL, w))
+		writeLine(&b, "TRANSP:TRANSPARENT")
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// summaryText is the plain-text counterpart of summaryHTML for calendar descriptions.
+func summaryText(w *word.Word) string {
+	var lines []string
+	if len(w.KoreanMeanings) > 0 {
+		lines = append(lines, strings.Join(w.KoreanMeanings, ", "))
+	}
+	if w.EnglishMeaning != "" {
+		lines = append(lines, w.EnglishMeaning)
+	}
+	for _, example := range w.Examples {
+		lines = append(lines, "- "+example.ExampleText)
+		if example.KoreanText != "" {
+			lines = append(lines, "  "+example.KoreanText)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// escapeText escapes a TEXT property value as RFC 5545 section 3.3.11 requires.
+func escapeText(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(value)
+}
+
+// writeLine writes a CRLF-terminated content line, folded every 75 octets without splitting
+// a multi-byte character such as Hangul across lines.
+func writeLine(b *strings.Builder, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards their length.
+		limit = maxLineOctets - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
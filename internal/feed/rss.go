@@ -0,0 +1,69 @@
+package feed
+
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS renders words, newest first, as an RSS 2.0 feed.
+func RSS(words []*word.Word, baseURL string) ([]byte, error) {
+	channel := rssChannel{
+		Title:       feedTitle,
+		Link:        baseURL,
+		Description: feedDescription,
+		Language:    "ko",
+	}
+	if lastModified := LastModified(words); !lastModified.IsZero() {
+		channel.LastBuildDate = lastModified.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, w := range words {
+		channel.Items = append(channel.Items, rssItem{
+			Title:       w.Text,
+			Link:        wordURL(baseURL, w),
+			Description: summaryHTML(w),
+			GUID:        rssGUID{Value: wordID(w)},
+			PubDate:     w.DeliveredAt.UTC().Format(time.RFC1123Z),
+		})
+	}
+
+	return marshal(rssFeed{Version: "2.0", Channel: channel})
+}
+
+func marshal(v any) ([]byte, error) {
+	body, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
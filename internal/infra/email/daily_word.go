@@ -0,0 +1,114 @@
+package email
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+const dictionaryURL = "https://en.dict.naver.com/#/search?query="
+
+// Recipient is a verified subscriber a daily word is sent to.
+type Recipient struct {
+	SubscriptionID string
+	Email          string
+	Username       string
+	TrackingOptOut bool
+	// PreferencesLink is the signed self-service link for changing delivery preferences.
+	PreferencesLink string
+}
+
+// Tracker signs the open pixel and click redirect links embedded in daily word emails.
+type Tracker interface {
+	OpenPixelURL(subscriptionID, wordID string) (string, error)
+	ClickURL(subscriptionID, wordID, target string) (string, error)
+	OptOutURL(subscriptionID string) (string, error)
+}
+
+// SetTracker enables open and click tracking for recipients that have not opted out.
+func (gs *GmailSender) SetTracker(tracker Tracker) {
+	gs.tracker = tracker
+}
+
+// ReviewItem is a previously delivered word mixed into the daily email for spaced repetition.
+type ReviewItem struct {
+	Word           *word.Word
+	RememberedLink string
+	ForgotLink     string
+}
+
+type DailyWordTemplateData struct {
+	Username         string
+	Word             *word.Word
+	Reviews          []ReviewItem
+	DictionaryLink   string
+	AudioLink        string
+	TrackingPixelURL string
+	OptOutLink       string
+	PreferencesLink  string
+}
+
+// SendDailyWordEmail sends dailyWord together with the reviews that are due for the recipient.
+func (gs *GmailSender) SendDailyWordEmail(recipient *Recipient, dailyWord *word.Word, reviews []ReviewItem) error {
+	data, err := gs.dailyWordTemplateData(recipient, dailyWord)
+	if err != nil {
+		return err
+	}
+	data.Reviews = reviews
+
+	m, err := gs.newMessage(recipient.Email, dailyWordSubject(dailyWord), gs.dailyWordTemplate, data)
+	if err != nil {
+		return err
+	}
+
+	if err := gs.send(m); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	log.Println("✅ Daily word email sent successfully to", recipient.Email)
+	return nil
+}
+
+func dailyWordSubject(dailyWord *word.Word) string {
+	return fmt.Sprintf("Wordrop - 오늘의 단어: %s", dailyWord.Text)
+}
+
+func (gs *GmailSender) dailyWordTemplateData(recipient *Recipient, dailyWord *word.Word) (*DailyWordTemplateData, error) {
+	data := &DailyWordTemplateData{
+		Username:        recipient.Username,
+		Word:            dailyWord,
+		DictionaryLink:  dictionaryURL + url.QueryEscape(dailyWord.Text),
+		AudioLink:       dailyWord.AudioURL,
+		PreferencesLink: recipient.PreferencesLink,
+	}
+
+	if gs.tracker == nil || recipient.TrackingOptOut {
+		return data, nil
+	}
+
+	wordID := dailyWord.ID.Hex()
+	pixelURL, err := gs.tracker.OpenPixelURL(recipient.SubscriptionID, wordID)
+	if err != nil {
+		return nil, err
+	}
+	dictionaryLink, err := gs.tracker.ClickURL(recipient.SubscriptionID, wordID, data.DictionaryLink)
+	if err != nil {
+		return nil, err
+	}
+	if data.AudioLink != "" {
+		if data.AudioLink, err = gs.tracker.ClickURL(recipient.SubscriptionID, wordID, data.AudioLink); err != nil {
+			return nil, err
+		}
+	}
+	optOutLink, err := gs.tracker.OptOutURL(recipient.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	data.TrackingPixelURL = pixelURL
+	data.DictionaryLink = dictionaryLink
+	data.OptOutLink = optOutLink
+	return data, nil
+}
@@ -0,0 +1,119 @@
+package email
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/emersion/go-msgauth/dkim"
+	"gopkg.in/gomail.v2"
+)
+
+const (
+	dkimDomainEnv     = "DKIM_DOMAIN"
+	dkimSelectorEnv   = "DKIM_SELECTOR"
+	dkimPrivateKeyEnv = "DKIM_PRIVATE_KEY_PATH"
+)
+
+// dkimHeaderKeys are the header fields covered by the signature.
+var dkimHeaderKeys = []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type"}
+
+type DkimConfig struct {
+	domain   string
+	selector string
+	signer   crypto.Signer
+}
+
+// NewDkimConfigFromEnv loads the DKIM signing key configured in the environment.
+// It returns nil without an error when DKIM_DOMAIN is not set, which disables signing.
+func NewDkimConfigFromEnv() (*DkimConfig, error) {
+	domain := os.Getenv(dkimDomainEnv)
+	if domain == "" {
+		return nil, nil
+	}
+
+	for _, envKey := range []string{dkimSelectorEnv, dkimPrivateKeyEnv} {
+		if value, ok := os.LookupEnv(envKey); value == "" || !ok {
+			return nil, fmt.Errorf("environment variable %s is not set", envKey)
+		}
+	}
+
+	keyPEM, err := os.ReadFile(os.Getenv(dkimPrivateKeyEnv))
+	if err != nil {
+		return nil, fmt.Errorf("could not read DKIM private key: %w", err)
+	}
+
+	return NewDkimConfig(domain, os.Getenv(dkimSelectorEnv), keyPEM)
+}
+
+// NewDkimConfig accepts an RSA (PKCS#1 or PKCS#8) or Ed25519 (PKCS#8) private key in PEM format.
+func NewDkimConfig(domain, selector string, keyPEM []byte) (*DkimConfig, error) {
+	signer, err := parseDkimPrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DkimConfig{
+		domain:   domain,
+		selector: selector,
+		signer:   signer,
+	}, nil
+}
+
+func parseDkimPrivateKey(keyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("DKIM private key is not PEM encoded")
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse DKIM RSA private key: %w", err)
+		}
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse DKIM private key: %w", err)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return key, nil
+	case ed25519.PrivateKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported DKIM key type %T", key)
+	}
+}
+
+// sign serializes m and returns it with a DKIM-Signature header prepended.
+func (c *DkimConfig) sign(m *gomail.Message) (*bytes.Buffer, error) {
+	var raw bytes.Buffer
+	if _, err := m.WriteTo(&raw); err != nil {
+		return nil, fmt.Errorf("could not serialize message: %w", err)
+	}
+
+	options := &dkim.SignOptions{
+		Domain:                 c.domain,
+		Selector:               c.selector,
+		Signer:                 c.signer,
+		HeaderCanonicalization: dkim.CanonicalizationRelaxed,
+		BodyCanonicalization:   dkim.CanonicalizationRelaxed,
+		HeaderKeys:             dkimHeaderKeys,
+	}
+
+	var signed bytes.Buffer
+	if err := dkim.Sign(&signed, &raw, options); err != nil {
+		return nil, fmt.Errorf("could not sign message: %w", err)
+	}
+	return &signed, nil
+}
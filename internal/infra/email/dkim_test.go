@@ -0,0 +1,111 @@
+package email
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/emersion/go-msgauth/dkim"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/gomail.v2"
+)
+
+func TestDkimConfig_Sign(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPublicKey, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	rsaPKCS8, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	require.NoError(t, err)
+
+	edPublicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edPKCS8, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		keyPEM    []byte
+		dnsRecord string
+	}{
+		{
+			name:      "RSA PKCS1",
+			keyPEM:    pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+			dnsRecord: "v=DKIM1; k=rsa; p=" + base64.StdEncoding.EncodeToString(rsaPublicKey),
+		},
+		{
+			name:      "RSA PKCS8",
+			keyPEM:    pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rsaPKCS8}),
+			dnsRecord: "v=DKIM1; k=rsa; p=" + base64.StdEncoding.EncodeToString(rsaPublicKey),
+		},
+		{
+			name:      "Ed25519",
+			keyPEM:    pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edPKCS8}),
+			dnsRecord: "v=DKIM1; k=ed25519; p=" + base64.StdEncoding.EncodeToString(edPublicKey),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := NewDkimConfig("wordrop.com", "mail", tt.keyPEM)
+			require.NoError(t, err)
+
+			signed, err := config.sign(dkimMessageFixture())
+			require.NoError(t, err)
+
+			verifications, err := dkim.VerifyWithOptions(signed, &dkim.VerifyOptions{
+				LookupTXT: func(domain string) ([]string, error) {
+					assert.Equal(t, "mail._domainkey.wordrop.com", domain)
+					return []string{tt.dnsRecord}, nil
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, verifications, 1)
+			assert.NoError(t, verifications[0].Err, "Expected signature to verify")
+			assert.Equal(t, "wordrop.com", verifications[0].Domain)
+		})
+	}
+}
+
+func TestDkimConfig_TamperedMessageFailsVerification(t *testing.T) {
+	edPublicKey, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edPKCS8, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+
+	config, err := NewDkimConfig("wordrop.com", "mail", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edPKCS8}))
+	require.NoError(t, err)
+
+	signed, err := config.sign(dkimMessageFixture())
+	require.NoError(t, err)
+	signed.WriteString("tampered\r\n")
+
+	verifications, err := dkim.VerifyWithOptions(signed, &dkim.VerifyOptions{
+		LookupTXT: func(string) ([]string, error) {
+			return []string{"v=DKIM1; k=ed25519; p=" + base64.StdEncoding.EncodeToString(edPublicKey)}, nil
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, verifications, 1)
+	assert.Error(t, verifications[0].Err, "Expected tampered body to fail verification")
+}
+
+func TestNewDkimConfig_InvalidKey(t *testing.T) {
+	_, err := NewDkimConfig("wordrop.com", "mail", []byte("not a key"))
+	assert.Error(t, err)
+}
+
+func dkimMessageFixture() *gomail.Message {
+	m := gomail.NewMessage()
+	m.SetHeader("From", "noreply@wordrop.com")
+	m.SetHeader("To", "user@example.com")
+	m.SetHeader("Subject", "Wordrop - 오늘의 단어")
+	m.SetBody("text/plain", "오늘의 단어: serendipity")
+	m.AddAlternative("text/html", "<p>오늘의 단어: <strong>serendipity</strong></p>")
+	return m
+}
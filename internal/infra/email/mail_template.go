@@ -0,0 +1,46 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"path/filepath"
+	texttemplate "text/template"
+)
+
+const templateDir = "template"
+
+// mailTemplate pairs the HTML body of a mail with its plain-text alternative.
+// Every template in templateDir must exist as both <name>.html and <name>.txt.
+type mailTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+func parseMailTemplate(name string) (*mailTemplate, error) {
+	html, err := htmltemplate.ParseFiles(filepath.Join(templateDir, name+".html"))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s html template: %w", name, err)
+	}
+
+	text, err := texttemplate.ParseFiles(filepath.Join(templateDir, name+".txt"))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse %s text template: %w", name, err)
+	}
+
+	return &mailTemplate{html: html, text: text}, nil
+}
+
+func (t *mailTemplate) render(data any) (html string, text string, err error) {
+	var htmlBody bytes.Buffer
+	if err := t.html.Execute(&htmlBody, data); err != nil {
+		return "", "", fmt.Errorf("could not execute html template: %w", err)
+	}
+
+	var textBody bytes.Buffer
+	if err := t.text.Execute(&textBody, data); err != nil {
+		return "", "", fmt.Errorf("could not execute text template: %w", err)
+	}
+
+	return htmlBody.String(), textBody.String(), nil
+}
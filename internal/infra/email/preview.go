@@ -0,0 +1,132 @@
+package email
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var ErrUnknownTemplate = errors.New("unknown email template")
+
+type Preview struct {
+	Subject string `json:"subject"`
+	HTML    string `json:"html"`
+	Text    string `json:"text"`
+}
+
+type previewData func(gs *GmailSender, dailyWord *word.Word) (subject string, tmpl *mailTemplate, data any, err error)
+
+// previewTemplates registers every mail template together with the sample data it is previewed with.
+var previewTemplates = map[string]previewData{
+	"verification": func(gs *GmailSender, _ *word.Word) (string, *mailTemplate, any, error) {
+		data := VerificationTemplateData{
+			Username:         previewUsername,
+			VerificationLink: fmt.Sprintf("%s/subscriptions/verify?token=preview", os.Getenv("APP_BASE_URL")),
+		}
+		return verificationSubject, gs.verificationTemplate, data, nil
+	},
+	"manage-link": func(gs *GmailSender, _ *word.Word) (string, *mailTemplate, any, error) {
+		data := ManageLinkTemplateData{
+			Username:   previewUsername,
+			ManageLink: fmt.Sprintf("%s/manage/login?token=preview", os.Getenv("APP_BASE_URL")),
+		}
+		return manageLinkSubject, gs.manageLinkTemplate, data, nil
+	},
+	"daily-word": func(gs *GmailSender, dailyWord *word.Word) (string, *mailTemplate, any, error) {
+		if dailyWord == nil {
+			dailyWord = sampleWord()
+		}
+		// The recipient opts out of tracking so no signed link is made; previews get placeholders instead,
+		// which cannot record opens or clicks or change anyone's preferences when the preview is shared.
+		recipient := &Recipient{
+			Username:        previewUsername,
+			TrackingOptOut:  true,
+			PreferencesLink: fmt.Sprintf("%s/subscriptions/preferences?token=preview", os.Getenv("APP_BASE_URL")),
+		}
+		data, err := gs.dailyWordTemplateData(recipient, dailyWord)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		data.TrackingPixelURL = fmt.Sprintf("%s/tracking/open/preview", os.Getenv("APP_BASE_URL"))
+		data.OptOutLink = fmt.Sprintf("%s/tracking/opt-out/preview", os.Getenv("APP_BASE_URL"))
+		data.Reviews = []ReviewItem{{
+			Word:           &word.Word{Text: "ephemeral", KoreanMeanings: []string{"덧없는", "단명하는"}},
+			RememberedLink: fmt.Sprintf("%s/reviews/answer/preview", os.Getenv("APP_BASE_URL")),
+			ForgotLink:     fmt.Sprintf("%s/reviews/answer/preview", os.Getenv("APP_BASE_URL")),
+		}}
+		return dailyWordSubject(dailyWord), gs.dailyWordTemplate, data, nil
+	},
+	"weekly-quiz": func(gs *GmailSender, _ *word.Word) (string, *mailTemplate, any, error) {
+		link := fmt.Sprintf("%s/quizzes/answer/preview", os.Getenv("APP_BASE_URL"))
+		data := newQuizTemplateData(previewUsername, []QuizQuestion{
+			{Prompt: "serendipity", Choices: []QuizChoice{
+				{Text: "덧없는, 단명하는", Link: link},
+				{Text: "뜻밖의 행운, 우연한 발견", Link: link},
+				{Text: "회복력", Link: link},
+			}},
+		})
+		return quizSubject, gs.quizTemplate, data, nil
+	},
+}
+
+const previewUsername = "미리보기"
+
+func TemplateNames() []string {
+	names := make([]string, 0, len(previewTemplates))
+	for name := range previewTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// RenderPreview renders the named template with sample data, or with dailyWord when given.
+// The inline logo is replaced by a data URI so the HTML can be opened directly in a browser.
+func (gs *GmailSender) RenderPreview(name string, dailyWord *word.Word) (*Preview, error) {
+	build, ok := previewTemplates[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, name)
+	}
+
+	subject, tmpl, data, err := build(gs, dailyWord)
+	if err != nil {
+		return nil, err
+	}
+
+	html, text, err := tmpl.render(data)
+	if err != nil {
+		return nil, err
+	}
+
+	logoURI := "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(gs.logo)
+	return &Preview{
+		Subject: subject,
+		HTML:    strings.ReplaceAll(html, "cid:"+logoFileName, logoURI),
+		Text:    text,
+	}, nil
+}
+
+func sampleWord() *word.Word {
+	return &word.Word{
+		ID:             primitive.NilObjectID,
+		Text:           "serendipity",
+		EnglishMeaning: "the occurrence of events by chance in a happy or beneficial way",
+		KoreanMeanings: []string{"뜻밖의 행운", "우연한 발견"},
+		PartsOfSpeech:  []word.PartOfSpeech{word.Noun, word.Noun},
+		IPA:            "ˌsɛɹənˈdɪpɪti",
+		Description:    "원하던 것을 찾다가 우연히 더 좋은 것을 발견하는 상황에 쓰입니다.",
+		Examples: []word.Example{
+			{
+				ExampleText: "Finding this café was pure serendipity.",
+				KoreanText:  "이 카페를 발견한 것은 순전히 뜻밖의 행운이었다.",
+			},
+		},
+		Synonyms: []string{"chance", "fluke", "luck"},
+	}
+}
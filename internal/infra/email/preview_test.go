@@ -0,0 +1,52 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGmailSender_RenderPreview(t *testing.T) {
+	sender, err := NewMailSender(&GmailSenderConfig{logoPath: "../../../assets/wordrop_logo_kr.jpg"})
+	require.NoError(t, err)
+
+	for _, name := range TemplateNames() {
+		t.Run(name, func(t *testing.T) {
+			preview, err := sender.RenderPreview(name, nil)
+			require.NoError(t, err)
+
+			assert.NotEmpty(t, preview.Subject)
+			assert.NotEmpty(t, preview.Text)
+			assert.Contains(t, preview.HTML, "data:image/jpeg;base64,", "Expected logo to be inlined as data URI")
+			assert.NotContains(t, preview.HTML, "cid:"+logoFileName)
+		})
+	}
+
+	t.Run("Daily Word With Real Word", func(t *testing.T) {
+		preview, err := sender.RenderPreview("daily-word", &word.Word{Text: "ephemeral", KoreanMeanings: []string{"덧없는"}})
+		require.NoError(t, err)
+
+		assert.Contains(t, preview.Subject, "ephemeral")
+		assert.Contains(t, preview.Text, "덧없는")
+	})
+
+	t.Run("Daily Word Without Signed Links", func(t *testing.T) {
+		sender.SetTracker(fakeTracker{})
+		defer sender.SetTracker(nil)
+
+		preview, err := sender.RenderPreview("daily-word", nil)
+		require.NoError(t, err)
+
+		assert.NotContains(t, preview.HTML, "localhost/tracking", "Expected no link signed by the tracker")
+		assert.Contains(t, preview.HTML, "/tracking/open/preview")
+		assert.Contains(t, preview.HTML, "/tracking/opt-out/preview")
+		assert.Contains(t, preview.Text, "/subscriptions/preferences?token=preview")
+	})
+
+	t.Run("Unknown Template", func(t *testing.T) {
+		_, err := sender.RenderPreview("newsletter", nil)
+		assert.ErrorIs(t, err, ErrUnknownTemplate)
+	})
+}
@@ -0,0 +1,57 @@
+package email
+
+import (
+	"fmt"
+	"log"
+)
+
+const quizSubject = "Wordrop - 이번 주 단어 퀴즈"
+
+// QuizChoice is one possible answer, linked to the signed URL that records it.
+// Label is filled in when the email is rendered.
+type QuizChoice struct {
+	Label string
+	Text  string
+	Link  string
+}
+
+// Number is filled in when the email is rendered.
+type QuizQuestion struct {
+	Number  int
+	Prompt  string
+	Choices []QuizChoice
+}
+
+type QuizTemplateData struct {
+	Username  string
+	Questions []QuizQuestion
+}
+
+func (gs *GmailSender) SendQuizEmail(recipient *Recipient, questions []QuizQuestion) error {
+	data := newQuizTemplateData(recipient.Username, questions)
+
+	m, err := gs.newMessage(recipient.Email, quizSubject, gs.quizTemplate, data)
+	if err != nil {
+		return err
+	}
+
+	if err := gs.send(m); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	log.Println("✅ Weekly quiz email sent successfully to", recipient.Email)
+	return nil
+}
+
+func newQuizTemplateData(username string, questions []QuizQuestion) QuizTemplateData {
+	numbered := make([]QuizQuestion, len(questions))
+	for i, question := range questions {
+		question.Number = i + 1
+		question.Choices = append([]QuizChoice(nil), question.Choices...)
+		for j := range question.Choices {
+			question.Choices[j].Label = string(rune('A' + j))
+		}
+		numbered[i] = question
+	}
+	return QuizTemplateData{Username: username, Questions: numbered}
+}
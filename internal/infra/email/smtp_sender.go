@@ -1,9 +1,8 @@
 package email
 
 import (
-	"bytes"
 	"fmt"
-	"html/template"
+	"io"
 	"log"
 	"os"
 	"strconv"
@@ -16,6 +15,13 @@ const (
 	passwordEnv  = "EMAIL_SENDER_PASSWORD"
 	smtpHostEnv  = "SMTP_HOST"
 	smtpPortEnv  = "SMTP_PORT"
+	logoPathEnv  = "EMAIL_LOGO_PATH"
+)
+
+const (
+	defaultLogoPath = "assets/wordrop_logo_kr.jpg"
+	// logoFileName is also the Content-ID of the inline logo, templates reference it as cid:wordrop_logo_kr.jpg.
+	logoFileName = "wordrop_logo_kr.jpg"
 )
 
 type GmailSenderConfig struct {
@@ -23,6 +29,8 @@ type GmailSenderConfig struct {
 	password  string
 	smtpHost  string
 	smtpPort  int
+	logoPath  string
+	dkim      *DkimConfig
 }
 
 func NewMailSenderConfig() (*GmailSenderConfig, error) {
@@ -41,35 +49,78 @@ func NewMailSenderConfigByKey(fromEmailKey, passwordKey, smtpHostKey, smtpPortKe
 		return nil, fmt.Errorf("failed to convert %s in .env.email-test file to integer: %w", smtpPortKey, err)
 	}
 
+	logoPath := defaultLogoPath
+	if value, ok := os.LookupEnv(logoPathEnv); ok && value != "" {
+		logoPath = value
+	}
+
+	dkimConfig, err := NewDkimConfigFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load DKIM config: %w", err)
+	}
+
 	return &GmailSenderConfig{
 		fromEmail: os.Getenv(fromEmailKey),
 		password:  os.Getenv(passwordKey),
 		smtpHost:  os.Getenv(smtpHostKey),
 		smtpPort:  smtpPort,
+		logoPath:  logoPath,
+		dkim:      dkimConfig,
 	}, nil
 }
 
 type GmailSender struct {
 	dialer               *gomail.Dialer
 	config               *GmailSenderConfig
-	verificationTemplate *template.Template
+	logo                 []byte
+	tracker              Tracker
+	verificationTemplate *mailTemplate
+	dailyWordTemplate    *mailTemplate
+	manageLinkTemplate   *mailTemplate
+	quizTemplate         *mailTemplate
 }
 
 func NewMailSender(config *GmailSenderConfig) (*GmailSender, error) {
 	dialer := gomail.NewDialer(config.smtpHost, config.smtpPort, config.fromEmail, config.password)
 
-	verificationTemplate, err := template.ParseFiles("template/verification.html")
+	verificationTemplate, err := parseMailTemplate("verification")
 	if err != nil {
 		return nil, fmt.Errorf("could not parse verification template: %w", err)
 	}
 
+	dailyWordTemplate, err := parseMailTemplate("daily-word")
+	if err != nil {
+		return nil, fmt.Errorf("could not parse daily word template: %w", err)
+	}
+
+	manageLinkTemplate, err := parseMailTemplate("manage-link")
+	if err != nil {
+		return nil, fmt.Errorf("could not parse manage link template: %w", err)
+	}
+
+	quizTemplate, err := parseMailTemplate("weekly-quiz")
+	if err != nil {
+		return nil, fmt.Errorf("could not parse weekly quiz template: %w", err)
+	}
+
+	logo, err := os.ReadFile(config.logoPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read logo %s: %w", config.logoPath, err)
+	}
+
 	return &GmailSender{
 		dialer:               dialer,
 		config:               config,
+		logo:                 logo,
 		verificationTemplate: verificationTemplate,
+		dailyWordTemplate:    dailyWordTemplate,
+		manageLinkTemplate:   manageLinkTemplate,
+		quizTemplate:         quizTemplate,
 	}, nil
 }
 
+const verificationSubject = "Wordrop - 이메일 주소를 인증해주세요"
+
 type VerificationTemplateData struct {
 	Username         string
 	VerificationLink string
@@ -84,22 +135,82 @@ func (gs *GmailSender) SendVerificationEmail(toEmail string, username string, ve
 		VerificationLink: verificationLink,
 	}
 
-	var body bytes.Buffer
-	err := gs.verificationTemplate.Execute(&body, data)
+	m, err := gs.newMessage(toEmail, verificationSubject, gs.verificationTemplate, data)
 	if err != nil {
-		return fmt.Errorf("could not execute template: %w", err)
+		return err
+	}
+
+	if err := gs.send(m); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	log.Println("✅ Verification email sent successfully to", toEmail)
+	return nil
+}
+
+const manageLinkSubject = "Wordrop - 구독 관리 링크"
+
+type ManageLinkTemplateData struct {
+	Username   string
+	ManageLink string
+}
+
+func (gs *GmailSender) SendManageLinkEmail(toEmail string, username string, manageLink string) error {
+	data := ManageLinkTemplateData{
+		Username:   username,
+		ManageLink: manageLink,
+	}
+
+	m, err := gs.newMessage(toEmail, manageLinkSubject, gs.manageLinkTemplate, data)
+	if err != nil {
+		return err
+	}
+
+	if err := gs.send(m); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	log.Println("✅ Manage link email sent successfully to", toEmail)
+	return nil
+}
+
+// newMessage renders tmpl into a text/plain body with a text/html alternative
+// and attaches the Wordrop logo inline so the HTML part can reference it by CID.
+func (gs *GmailSender) newMessage(toEmail, subject string, tmpl *mailTemplate, data any) (*gomail.Message, error) {
+	html, text, err := tmpl.render(data)
+	if err != nil {
+		return nil, err
 	}
 
 	m := gomail.NewMessage()
 	m.SetHeader("From", gs.config.fromEmail)
 	m.SetHeader("To", toEmail)
-	m.SetHeader("Subject", "Wordrop - 이메일 주소를 인증해주세요")
-	m.SetBody("text/html", body.String())
+	m.SetHeader("Subject", subject)
+	m.SetBody("text/plain", text)
+	m.AddAlternative("text/html", html)
+	m.Embed(logoFileName, gomail.SetCopyFunc(func(w io.Writer) error {
+		_, err := w.Write(gs.logo)
+		return err
+	}))
+	return m, nil
+}
 
-	if err := gs.dialer.DialAndSend(m); err != nil {
-		return fmt.Errorf("failed to send email: %w", err)
+// send hands m to the SMTP transport, signing it first when DKIM is configured.
+func (gs *GmailSender) send(m *gomail.Message) error {
+	if gs.config.dkim == nil {
+		return gs.dialer.DialAndSend(m)
 	}
 
-	log.Println("✅ Verification email sent successfully to", toEmail)
-	return nil
+	signed, err := gs.config.dkim.sign(m)
+	if err != nil {
+		return err
+	}
+
+	s, err := gs.dialer.Dial()
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	return s.Send(gs.config.fromEmail, m.GetHeader("To"), signed)
 }
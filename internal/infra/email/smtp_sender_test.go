@@ -1,6 +1,7 @@
 package email
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,8 +10,12 @@ import (
 	"testing"
 
 	"github.com/Go-roro/wordrop/internal/infra/testhelper"
+	"github.com/Go-roro/wordrop/internal/word"
 	"github.com/joho/godotenv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 const testEnvPath = "../testhelper/.env.email-test"
@@ -80,6 +85,95 @@ func (suite *EmailSenderTestSuite) TestSendVerificationEmail() {
 		latestEmail := mailHogResp.Items[0]
 		suite.Contains(latestEmail.Content.Body, username, "Email body should contain the correct username")
 		suite.Contains(latestEmail.Content.Body, token, "Email body should contain the correct verification link")
+		suite.Contains(latestEmail.Content.Body, "text/plain", "Email should carry a plain-text alternative")
+		suite.Contains(latestEmail.Content.Body, "<"+logoFileName+">", "Email should embed the logo inline")
+	})
+}
+
+func TestGmailSender_NewMessage(t *testing.T) {
+	sender, err := NewMailSender(&GmailSenderConfig{
+		fromEmail: "noreply.test@wordrop.com",
+		smtpHost:  "localhost",
+		smtpPort:  1025,
+		logoPath:  "../../../assets/wordrop_logo_kr.jpg",
+	})
+	require.NoError(t, err)
+
+	data := VerificationTemplateData{Username: "test-user", VerificationLink: "http://localhost/verify?token=abc"}
+	m, err := sender.newMessage("new-user@example.com", "subject", sender.verificationTemplate, data)
+	require.NoError(t, err)
+
+	var raw bytes.Buffer
+	_, err = m.WriteTo(&raw)
+	require.NoError(t, err)
+
+	message := raw.String()
+	assert.Contains(t, message, "multipart/alternative")
+	assert.Contains(t, message, "Content-Type: text/plain; charset=UTF-8")
+	assert.Contains(t, message, "Content-Type: text/html; charset=UTF-8")
+	assert.Contains(t, message, "Content-ID: <"+logoFileName+">", "Logo should be attached inline")
+	assert.Contains(t, message, "cid:"+logoFileName, "HTML part should reference the inline logo")
+}
+
+type fakeTracker struct{}
+
+func (fakeTracker) OpenPixelURL(subscriptionID, wordID string) (string, error) {
+	return "http://localhost/tracking/open/" + subscriptionID + wordID, nil
+}
+
+func (fakeTracker) ClickURL(subscriptionID, wordID, target string) (string, error) {
+	return "http://localhost/tracking/click/" + subscriptionID + wordID, nil
+}
+
+func (fakeTracker) OptOutURL(subscriptionID string) (string, error) {
+	return "http://localhost/tracking/opt-out/" + subscriptionID, nil
+}
+
+func TestGmailSender_DailyWordTemplateData(t *testing.T) {
+	sender, err := NewMailSender(&GmailSenderConfig{
+		fromEmail: "noreply.test@wordrop.com",
+		logoPath:  "../../../assets/wordrop_logo_kr.jpg",
+	})
+	require.NoError(t, err)
+	sender.SetTracker(fakeTracker{})
+
+	dailyWord := &word.Word{
+		ID:             primitive.NewObjectID(),
+		Text:           "serendipity",
+		KoreanMeanings: []string{"뜻밖의 행운"},
+		PartsOfSpeech:  []word.PartOfSpeech{word.Noun},
+		IPA:            "ˌsɛɹənˈdɪpɪti",
+		AudioURL:       "https://media.wordrop.com/audio/serendipity.mp3",
+		Examples:       []word.Example{{ExampleText: "It was pure serendipity.", KoreanText: "순전히 우연이었다."}},
+	}
+
+	t.Run("Tracked Recipient", func(t *testing.T) {
+		data, err := sender.dailyWordTemplateData(&Recipient{SubscriptionID: "sub", Email: "user@example.com"}, dailyWord)
+		require.NoError(t, err)
+
+		assert.Contains(t, data.TrackingPixelURL, "/tracking/open/")
+		assert.Contains(t, data.DictionaryLink, "/tracking/click/")
+		assert.Contains(t, data.AudioLink, "/tracking/click/")
+		assert.Contains(t, data.OptOutLink, "/tracking/opt-out/")
+
+		html, text, err := sender.dailyWordTemplate.render(data)
+		require.NoError(t, err)
+		assert.Contains(t, html, data.TrackingPixelURL)
+		assert.Contains(t, text, "serendipity")
+		assert.Contains(t, text, "순전히 우연이었다.")
+		assert.Contains(t, text, "serendipity /ˌsɛɹənˈdɪpɪti/")
+		assert.Contains(t, text, "(명사) 뜻밖의 행운")
+		assert.Contains(t, html, `<span class="part-of-speech">명사</span> 뜻밖의 행운`)
+	})
+
+	t.Run("Opted Out Recipient", func(t *testing.T) {
+		data, err := sender.dailyWordTemplateData(&Recipient{SubscriptionID: "sub", TrackingOptOut: true}, dailyWord)
+		require.NoError(t, err)
+
+		assert.Empty(t, data.TrackingPixelURL)
+		assert.Empty(t, data.OptOutLink)
+		assert.Equal(t, dictionaryURL+"serendipity", data.DictionaryLink)
+		assert.Equal(t, dailyWord.AudioURL, data.AudioLink)
 	})
 }
 
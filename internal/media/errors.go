@@ -0,0 +1,12 @@
+package media
+
+import "errors"
+
+var (
+	ErrUnsupportedType  = errors.New("unsupported media type")
+	ErrTooLarge         = errors.New("file too large")
+	ErrEmptyFile        = errors.New("file is empty")
+	ErrInvalidKey       = errors.New("invalid media key")
+	ErrInvalidSignature = errors.New("invalid or expired media link")
+	ErrFileNotFound     = errors.New("media file not found")
+)
@@ -0,0 +1,68 @@
+package media
+
+import (
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	provider, err := auth.NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	require.NoError(t, err)
+	storage, err := NewLocalStorage(t.TempDir(), "http://localhost:8080/", provider)
+	require.NoError(t, err)
+
+	key := "words/1/audio.mp3"
+	require.NoError(t, storage.Put(key, "audio/mpeg", strings.NewReader("ID3 recording"), 13))
+
+	t.Run("Signed URL Opens The File", func(t *testing.T) {
+		signed, err := storage.SignedURL(key, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		parsed, err := url.Parse(signed)
+		require.NoError(t, err)
+		assert.Equal(t, "/media/"+key, parsed.Path)
+
+		file, err := storage.Open(key, parsed.Query().Get("token"))
+		require.NoError(t, err)
+		defer file.Close()
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "ID3 recording", string(content))
+	})
+
+	t.Run("Failure-Token For Another File", func(t *testing.T) {
+		token, err := provider.GenerateMediaToken("words/1/other.mp3", time.Now().Add(time.Minute))
+		require.NoError(t, err)
+
+		_, err = storage.Open(key, token)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("Failure-Expired Token", func(t *testing.T) {
+		token, err := provider.GenerateMediaToken(key, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		_, err = storage.Open(key, token)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("Failure-Key Outside The Directory", func(t *testing.T) {
+		err := storage.Put("../escape.mp3", "audio/mpeg", strings.NewReader("x"), 1)
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		signed, _ := storage.SignedURL(key, time.Now().Add(time.Minute))
+		parsed, _ := url.Parse(signed)
+
+		require.NoError(t, storage.Delete(key))
+		_, err := storage.Open(key, parsed.Query().Get("token"))
+		assert.ErrorIs(t, err, ErrFileNotFound)
+		assert.NoError(t, storage.Delete(key), "Expected deleting a missing file to succeed")
+	})
+}
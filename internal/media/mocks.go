@@ -0,0 +1,547 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package media
+
+import (
+	"io"
+	"os"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWords creates a new instance of MockWords. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWords(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWords {
+	mock := &MockWords{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWords is an autogenerated mock type for the Words type
+type MockWords struct {
+	mock.Mock
+}
+
+type MockWords_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWords) EXPECT() *MockWords_Expecter {
+	return &MockWords_Expecter{mock: &_m.Mock}
+}
+
+// AddAttachment provides a mock function for the type MockWords
+func (_mock *MockWords) AddAttachment(wordID string, attachment word.Attachment) (*word.Word, error) {
+	ret := _mock.Called(wordID, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAttachment")
+	}
+
+	var r0 *word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, word.Attachment) (*word.Word, error)); ok {
+		return returnFunc(wordID, attachment)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, word.Attachment) *word.Word); ok {
+		r0 = returnFunc(wordID, attachment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, word.Attachment) error); ok {
+		r1 = returnFunc(wordID, attachment)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_AddAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAttachment'
+type MockWords_AddAttachment_Call struct {
+	*mock.Call
+}
+
+// AddAttachment is a helper method to define mock.On call
+//   - wordID string
+//   - attachment word.Attachment
+func (_e *MockWords_Expecter) AddAttachment(wordID interface{}, attachment interface{}) *MockWords_AddAttachment_Call {
+	return &MockWords_AddAttachment_Call{Call: _e.mock.On("AddAttachment", wordID, attachment)}
+}
+
+func (_c *MockWords_AddAttachment_Call) Run(run func(wordID string, attachment word.Attachment)) *MockWords_AddAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 word.Attachment
+		if args[1] != nil {
+			arg1 = args[1].(word.Attachment)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_AddAttachment_Call) Return(word1 *word.Word, err error) *MockWords_AddAttachment_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockWords_AddAttachment_Call) RunAndReturn(run func(wordID string, attachment word.Attachment) (*word.Word, error)) *MockWords_AddAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWord provides a mock function for the type MockWords
+func (_mock *MockWords) FindWord(id string) (*word.Word, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWord")
+	}
+
+	var r0 *word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*word.Word, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *word.Word); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_FindWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWord'
+type MockWords_FindWord_Call struct {
+	*mock.Call
+}
+
+// FindWord is a helper method to define mock.On call
+//   - id string
+func (_e *MockWords_Expecter) FindWord(id interface{}) *MockWords_FindWord_Call {
+	return &MockWords_FindWord_Call{Call: _e.mock.On("FindWord", id)}
+}
+
+func (_c *MockWords_FindWord_Call) Run(run func(id string)) *MockWords_FindWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) Return(word1 *word.Word, err error) *MockWords_FindWord_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) RunAndReturn(run func(id string) (*word.Word, error)) *MockWords_FindWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAttachment provides a mock function for the type MockWords
+func (_mock *MockWords) RemoveAttachment(wordID string, attachmentID string) (*word.Attachment, error) {
+	ret := _mock.Called(wordID, attachmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAttachment")
+	}
+
+	var r0 *word.Attachment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*word.Attachment, error)); ok {
+		return returnFunc(wordID, attachmentID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *word.Attachment); ok {
+		r0 = returnFunc(wordID, attachmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Attachment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(wordID, attachmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_RemoveAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAttachment'
+type MockWords_RemoveAttachment_Call struct {
+	*mock.Call
+}
+
+// RemoveAttachment is a helper method to define mock.On call
+//   - wordID string
+//   - attachmentID string
+func (_e *MockWords_Expecter) RemoveAttachment(wordID interface{}, attachmentID interface{}) *MockWords_RemoveAttachment_Call {
+	return &MockWords_RemoveAttachment_Call{Call: _e.mock.On("RemoveAttachment", wordID, attachmentID)}
+}
+
+func (_c *MockWords_RemoveAttachment_Call) Run(run func(wordID string, attachmentID string)) *MockWords_RemoveAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_RemoveAttachment_Call) Return(attachment *word.Attachment, err error) *MockWords_RemoveAttachment_Call {
+	_c.Call.Return(attachment, err)
+	return _c
+}
+
+func (_c *MockWords_RemoveAttachment_Call) RunAndReturn(run func(wordID string, attachmentID string) (*word.Attachment, error)) *MockWords_RemoveAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLocalFiles creates a new instance of MockLocalFiles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLocalFiles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLocalFiles {
+	mock := &MockLocalFiles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockLocalFiles is an autogenerated mock type for the LocalFiles type
+type MockLocalFiles struct {
+	mock.Mock
+}
+
+type MockLocalFiles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLocalFiles) EXPECT() *MockLocalFiles_Expecter {
+	return &MockLocalFiles_Expecter{mock: &_m.Mock}
+}
+
+// Open provides a mock function for the type MockLocalFiles
+func (_mock *MockLocalFiles) Open(key string, token string) (*os.File, error) {
+	ret := _mock.Called(key, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 *os.File
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*os.File, error)); ok {
+		return returnFunc(key, token)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *os.File); ok {
+		r0 = returnFunc(key, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*os.File)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(key, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLocalFiles_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type MockLocalFiles_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+//   - key string
+//   - token string
+func (_e *MockLocalFiles_Expecter) Open(key interface{}, token interface{}) *MockLocalFiles_Open_Call {
+	return &MockLocalFiles_Open_Call{Call: _e.mock.On("Open", key, token)}
+}
+
+func (_c *MockLocalFiles_Open_Call) Run(run func(key string, token string)) *MockLocalFiles_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLocalFiles_Open_Call) Return(file *os.File, err error) *MockLocalFiles_Open_Call {
+	_c.Call.Return(file, err)
+	return _c
+}
+
+func (_c *MockLocalFiles_Open_Call) RunAndReturn(run func(key string, token string) (*os.File, error)) *MockLocalFiles_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStorage creates a new instance of MockStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStorage {
+	mock := &MockStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStorage is an autogenerated mock type for the Storage type
+type MockStorage struct {
+	mock.Mock
+}
+
+type MockStorage_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStorage) EXPECT() *MockStorage_Expecter {
+	return &MockStorage_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockStorage
+func (_mock *MockStorage) Delete(key string) error {
+	ret := _mock.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStorage_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockStorage_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - key string
+func (_e *MockStorage_Expecter) Delete(key interface{}) *MockStorage_Delete_Call {
+	return &MockStorage_Delete_Call{Call: _e.mock.On("Delete", key)}
+}
+
+func (_c *MockStorage_Delete_Call) Run(run func(key string)) *MockStorage_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorage_Delete_Call) Return(err error) *MockStorage_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStorage_Delete_Call) RunAndReturn(run func(key string) error) *MockStorage_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function for the type MockStorage
+func (_mock *MockStorage) Put(key string, contentType string, body io.Reader, size int64) error {
+	ret := _mock.Called(key, contentType, body, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, io.Reader, int64) error); ok {
+		r0 = returnFunc(key, contentType, body, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStorage_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type MockStorage_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - key string
+//   - contentType string
+//   - body io.Reader
+//   - size int64
+func (_e *MockStorage_Expecter) Put(key interface{}, contentType interface{}, body interface{}, size interface{}) *MockStorage_Put_Call {
+	return &MockStorage_Put_Call{Call: _e.mock.On("Put", key, contentType, body, size)}
+}
+
+func (_c *MockStorage_Put_Call) Run(run func(key string, contentType string, body io.Reader, size int64)) *MockStorage_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 io.Reader
+		if args[2] != nil {
+			arg2 = args[2].(io.Reader)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorage_Put_Call) Return(err error) *MockStorage_Put_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStorage_Put_Call) RunAndReturn(run func(key string, contentType string, body io.Reader, size int64) error) *MockStorage_Put_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignedURL provides a mock function for the type MockStorage
+func (_mock *MockStorage) SignedURL(key string, expiresAt time.Time) (string, error) {
+	ret := _mock.Called(key, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignedURL")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, time.Time) (string, error)); ok {
+		return returnFunc(key, expiresAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, time.Time) string); ok {
+		r0 = returnFunc(key, expiresAt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = returnFunc(key, expiresAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStorage_SignedURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignedURL'
+type MockStorage_SignedURL_Call struct {
+	*mock.Call
+}
+
+// SignedURL is a helper method to define mock.On call
+//   - key string
+//   - expiresAt time.Time
+func (_e *MockStorage_Expecter) SignedURL(key interface{}, expiresAt interface{}) *MockStorage_SignedURL_Call {
+	return &MockStorage_SignedURL_Call{Call: _e.mock.On("SignedURL", key, expiresAt)}
+}
+
+func (_c *MockStorage_SignedURL_Call) Run(run func(key string, expiresAt time.Time)) *MockStorage_SignedURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStorage_SignedURL_Call) Return(s string, err error) *MockStorage_SignedURL_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockStorage_SignedURL_Call) RunAndReturn(run func(key string, expiresAt time.Time) (string, error)) *MockStorage_SignedURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,113 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	s3EndpointEnv  = "S3_ENDPOINT"
+	s3AccessKeyEnv = "S3_ACCESS_KEY"
+	s3SecretKeyEnv = "S3_SECRET_KEY"
+	s3BucketEnv    = "S3_BUCKET"
+	s3RegionEnv    = "S3_REGION"
+	s3UseSSLEnv    = "S3_USE_SSL"
+
+	defaultS3Region = "us-east-1"
+
+	// s3Timeout bounds one request to the object store, long enough to upload the largest file allowed.
+	s3Timeout = time.Minute
+)
+
+// S3Config points at a bucket of Amazon S3 or any service speaking its API, such as MinIO.
+type S3Config struct {
+	Endpoint  string
+	AccessKey string
+	SecretKey string
+	Bucket    string
+	Region    string
+	UseSSL    bool
+}
+
+func NewS3Config() (*S3Config, error) {
+	for _, envKey := range []string{s3EndpointEnv, s3AccessKeyEnv, s3SecretKeyEnv, s3BucketEnv} {
+		if value, ok := os.LookupEnv(envKey); value == "" || !ok {
+			return nil, fmt.Errorf("environment variable %s is not set", envKey)
+		}
+	}
+
+	region := defaultS3Region
+	if value, ok := os.LookupEnv(s3RegionEnv); ok && value != "" {
+		region = value
+	}
+	useSSL := true
+	if value, ok := os.LookupEnv(s3UseSSLEnv); ok && value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", s3UseSSLEnv, err)
+		}
+		useSSL = parsed
+	}
+
+	return &S3Config{
+		Endpoint:  os.Getenv(s3EndpointEnv),
+		AccessKey: os.Getenv(s3AccessKeyEnv),
+		SecretKey: os.Getenv(s3SecretKeyEnv),
+		Bucket:    os.Getenv(s3BucketEnv),
+		Region:    region,
+		UseSSL:    useSSL,
+	}, nil
+}
+
+// S3Storage keeps files in an S3-compatible bucket and hands out presigned links to them,
+// so the files never pass through this server on their way to a reader.
+type S3Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewS3Storage(config *S3Config) (*S3Storage, error) {
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: config.UseSSL,
+		// Knowing the region spares a bucket location lookup before every request.
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+	return &S3Storage{client: client, bucket: config.Bucket}, nil
+}
+
+func (s *S3Storage) Put(key, contentType string, body io.Reader, size int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s3Timeout)
+	defer cancel()
+
+	_, err := s.client.PutObject(ctx, s.bucket, key, body, size, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *S3Storage) Delete(key string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s3Timeout)
+	defer cancel()
+
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s *S3Storage) SignedURL(key string, expiresAt time.Time) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s3Timeout)
+	defer cancel()
+
+	signed, err := s.client.PresignedGetObject(ctx, s.bucket, key, time.Until(expiresAt), nil)
+	if err != nil {
+		return "", err
+	}
+	return signed.String(), nil
+}
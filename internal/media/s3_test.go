@@ -0,0 +1,105 @@
+package media
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3 is a stand-in for an S3-compatible server that keeps objects in memory. It checks no signatures.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	types   map[string]string
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		if strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+			body = decodeChunked(body)
+		}
+		f.objects[r.URL.Path] = body
+		f.types[r.URL.Path] = r.Header.Get("Content-Type")
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", f.types[r.URL.Path])
+		_, _ = w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// decodeChunked strips the signature framing of a streaming upload: "<size in hex>;chunk-signature=...\r\n<data>\r\n".
+func decodeChunked(body []byte) []byte {
+	var decoded []byte
+	for len(body) > 0 {
+		header, rest, _ := strings.Cut(string(body), "\r\n")
+		sizeHex, _, _ := strings.Cut(header, ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil || size == 0 {
+			break
+		}
+		decoded = append(decoded, rest[:size]...)
+		body = []byte(strings.TrimPrefix(rest[size:], "\r\n"))
+	}
+	return decoded
+}
+
+func TestS3Storage(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}, types: map[string]string{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	endpoint, _ := url.Parse(server.URL)
+	storage, err := NewS3Storage(&S3Config{
+		Endpoint:  endpoint.Host,
+		AccessKey: "access",
+		SecretKey: "secret",
+		Bucket:    "wordrop",
+		Region:    defaultS3Region,
+	})
+	require.NoError(t, err)
+
+	key := "words/1/audio.mp3"
+	require.NoError(t, storage.Put(key, "audio/mpeg", strings.NewReader("ID3 recording"), 13))
+	assert.Equal(t, "audio/mpeg", fake.types["/wordrop/"+key])
+
+	t.Run("Presigned URL", func(t *testing.T) {
+		signed, err := storage.SignedURL(key, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Contains(t, signed, "X-Amz-Signature=")
+
+		response, err := http.Get(signed)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		content, _ := io.ReadAll(response.Body)
+		assert.Equal(t, "ID3 recording", string(content))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, storage.Delete(key))
+		assert.NotContains(t, fake.objects, "/wordrop/"+key)
+	})
+}
@@ -0,0 +1,243 @@
+package media
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	MaxImageSize = 5 << 20
+	MaxAudioSize = 10 << 20
+	// MaxUploadSize is the largest file of any kind that can be uploaded.
+	MaxUploadSize = MaxAudioSize
+
+	// signedURLTTL is how long a link handed out for an attachment keeps working.
+	signedURLTTL = time.Hour
+)
+
+// mediaTypes lists the content types that can be uploaded, with what they hold and the extension they are stored with.
+var mediaTypes = map[string]struct {
+	kind      word.MediaKind
+	extension string
+}{
+	"image/jpeg": {word.MediaImage, ".jpg"},
+	"image/png":  {word.MediaImage, ".png"},
+	"image/gif":  {word.MediaImage, ".gif"},
+	"image/webp": {word.MediaImage, ".webp"},
+	"audio/mpeg": {word.MediaAudio, ".mp3"},
+	"audio/mp4":  {word.MediaAudio, ".m4a"},
+	"audio/ogg":  {word.MediaAudio, ".ogg"},
+	"audio/wav":  {word.MediaAudio, ".wav"},
+	"audio/webm": {word.MediaAudio, ".weba"},
+}
+
+// audioSignatures recognize each audio type by how its files start. http.DetectContentType knows only some of
+// them, and no MP3 without an ID3 tag.
+var audioSignatures = map[string]func(head []byte) bool{
+	"audio/mpeg": func(head []byte) bool {
+		// An ID3 tag, or straight away the sync bits of an MPEG audio frame.
+		return bytes.HasPrefix(head, []byte("ID3")) || len(head) >= 2 && head[0] == 0xFF && head[1]&0xE0 == 0xE0
+	},
+	"audio/mp4": func(head []byte) bool {
+		return len(head) >= 8 && string(head[4:8]) == "ftyp"
+	},
+	"audio/ogg": func(head []byte) bool {
+		return bytes.HasPrefix(head, []byte("OggS"))
+	},
+	"audio/wav": func(head []byte) bool {
+		return len(head) >= 12 && string(head[:4]) == "RIFF" && string(head[8:12]) == "WAVE"
+	},
+	"audio/webm": func(head []byte) bool {
+		// The EBML header that Matroska and WebM files open with.
+		return bytes.HasPrefix(head, []byte{0x1A, 0x45, 0xDF, 0xA3})
+	},
+}
+
+var maxSizes = map[word.MediaKind]int64{
+	word.MediaImage: MaxImageSize,
+	word.MediaAudio: MaxAudioSize,
+}
+
+type Words interface {
+	FindWord(id string) (*word.Word, error)
+	AddAttachment(wordID string, attachment word.Attachment) (*word.Word, error)
+	RemoveAttachment(wordID, attachmentID string) (*word.Attachment, error)
+}
+
+// LocalFiles is implemented by storage that serves its own files instead of linking to another server.
+type LocalFiles interface {
+	Open(key, token string) (*os.File, error)
+}
+
+type Service struct {
+	storage Storage
+	words   Words
+}
+
+func NewMediaService(storage Storage, words Words) *Service {
+	return &Service{
+		storage: storage,
+		words:   words,
+	}
+}
+
+// Upload is a file sent for a word. Size and ContentType are as declared by the client and are checked against Body.
+type Upload struct {
+	Filename    string
+	ContentType string
+	Size        int64
+	Body        io.Reader
+}
+
+// Link is an attachment with a signed URL it can be fetched from until ExpiresAt.
+type Link struct {
+	word.Attachment
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Upload checks a file, stores it and attaches it to the word.
+func (s *Service) Upload(wordID string, upload *Upload, editor string, now time.Time) (*Link, error) {
+	contentType, body, err := validate(upload)
+	if err != nil {
+		return nil, err
+	}
+	found, err := s.words.FindWord(wordID)
+	if err != nil || found.IsDeleted() {
+		return nil, word.ErrWordNotFound
+	}
+
+	mediaType := mediaTypes[contentType]
+	id := primitive.NewObjectID().Hex()
+	attachment := word.Attachment{
+		ID:          id,
+		Kind:        mediaType.kind,
+		Key:         fmt.Sprintf("words/%s/%s%s", found.ID.Hex(), id, mediaType.extension),
+		ContentType: contentType,
+		Size:        upload.Size,
+		Filename:    upload.Filename,
+		UploadedBy:  editor,
+		UploadedAt:  now,
+	}
+	if err := s.storage.Put(attachment.Key, contentType, body, upload.Size); err != nil {
+		return nil, fmt.Errorf("failed to store %s: %w", attachment.Key, err)
+	}
+
+	if _, err := s.words.AddAttachment(wordID, attachment); err != nil {
+		s.deleteFile(attachment.Key)
+		return nil, err
+	}
+	return s.link(attachment, now)
+}
+
+// Links returns the attachments of a word with freshly signed URLs.
+func (s *Service) Links(wordID string, now time.Time) ([]*Link, error) {
+	found, err := s.words.FindWord(wordID)
+	if err != nil || found.IsDeleted() {
+		return nil, word.ErrWordNotFound
+	}
+
+	links := make([]*Link, 0, len(found.Attachments))
+	for _, attachment := range found.Attachments {
+		link, err := s.link(attachment, now)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
+
+// Remove takes an attachment off a word and deletes its file.
+func (s *Service) Remove(wordID, attachmentID string) error {
+	attachment, err := s.words.RemoveAttachment(wordID, attachmentID)
+	if err != nil {
+		return err
+	}
+	s.deleteFile(attachment.Key)
+	return nil
+}
+
+// Open returns a file of local storage for a signed link; other storage never links back to this server.
+func (s *Service) Open(key, token string) (*os.File, error) {
+	files, ok := s.storage.(LocalFiles)
+	if !ok {
+		return nil, ErrFileNotFound
+	}
+	return files.Open(key, token)
+}
+
+// ContentType returns the type a stored file was uploaded as, told by the extension it was stored with.
+// Files are served with it rather than with a type guessed from the extension, which not every host knows.
+func ContentType(key string) string {
+	extension := path.Ext(key)
+	for contentType, mediaType := range mediaTypes {
+		if mediaType.extension == extension {
+			return contentType
+		}
+	}
+	return "application/octet-stream"
+}
+
+func (s *Service) link(attachment word.Attachment, now time.Time) (*Link, error) {
+	expiresAt := now.Add(signedURLTTL)
+	url, err := s.storage.SignedURL(attachment.Key, expiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign link to %s: %w", attachment.Key, err)
+	}
+	return &Link{Attachment: attachment, URL: url, ExpiresAt: expiresAt}, nil
+}
+
+// deleteFile removes a file no word refers to anymore. A file left behind costs only space, so failures are logged.
+func (s *Service) deleteFile(key string) {
+	if err := s.storage.Delete(key); err != nil {
+		log.Printf("Failed to delete media file %s: %v", key, err)
+	}
+}
+
+// validate checks the declared type and size of an upload against what is allowed and against the start of the
+// file itself, which must really be of the declared type. It returns the content type and a reader of the whole file.
+func validate(upload *Upload) (string, io.Reader, error) {
+	contentType, _, err := mime.ParseMediaType(upload.ContentType)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %q", ErrUnsupportedType, upload.ContentType)
+	}
+	mediaType, ok := mediaTypes[contentType]
+	if !ok {
+		return "", nil, fmt.Errorf("%w: %q", ErrUnsupportedType, contentType)
+	}
+	if upload.Size <= 0 {
+		return "", nil, ErrEmptyFile
+	}
+	if maxSize := maxSizes[mediaType.kind]; upload.Size > maxSize {
+		return "", nil, fmt.Errorf("%w: %s files are limited to %d MB", ErrTooLarge, mediaType.kind, maxSize>>20)
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(upload.Body, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	head = head[:n]
+
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	matches := sniffed == contentType
+	if mediaType.kind == word.MediaAudio {
+		matches = audioSignatures[contentType](head)
+	}
+	if !matches {
+		return "", nil, fmt.Errorf("%w: declared %s but the file looks like %s", ErrUnsupportedType, contentType, sniffed)
+	}
+
+	return contentType, io.MultiReader(bytes.NewReader(head), upload.Body), nil
+}
@@ -0,0 +1,181 @@
+package media
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// pngHeader is the start of every PNG file, enough for the content to be recognized.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+type MediaServiceTestSuite struct {
+	suite.Suite
+	mockStorage *MockStorage
+	mockWords   *MockWords
+	service     *Service
+}
+
+func (suite *MediaServiceTestSuite) SetupTest() {
+	suite.mockStorage = new(MockStorage)
+	suite.mockWords = new(MockWords)
+	suite.service = NewMediaService(suite.mockStorage, suite.mockWords)
+}
+
+func TestMediaServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(MediaServiceTestSuite))
+}
+
+func (suite *MediaServiceTestSuite) TestUpload_StoresAndAttaches() {
+	// Given
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	existing := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity"}
+	upload := &Upload{Filename: "picture.png", ContentType: "image/png", Size: int64(len(pngHeader)), Body: bytes.NewReader(pngHeader)}
+	suite.mockWords.EXPECT().FindWord(existing.ID.Hex()).Return(existing, nil)
+	suite.mockStorage.EXPECT().Put(mock.Anything, "image/png", mock.Anything, int64(len(pngHeader))).
+		RunAndReturn(func(key, contentType string, body io.Reader, size int64) error {
+			stored, _ := io.ReadAll(body)
+			suite.Equal(pngHeader, stored, "Expected the whole file to be stored")
+			return nil
+		})
+	suite.mockWords.EXPECT().AddAttachment(existing.ID.Hex(), mock.MatchedBy(func(attachment word.Attachment) bool {
+		return attachment.Kind == word.MediaImage && attachment.Filename == "picture.png" && attachment.UploadedBy == "editor@wordrop.com"
+	})).Return(existing, nil)
+	suite.mockStorage.EXPECT().SignedURL(mock.Anything, now.Add(signedURLTTL)).Return("https://media.example.com/signed", nil)
+
+	// When
+	link, err := suite.service.Upload(existing.ID.Hex(), upload, "editor@wordrop.com", now)
+
+	// Then
+	suite.NoError(err, "Expected no error when uploading")
+	suite.Equal("https://media.example.com/signed", link.URL)
+	suite.Regexp(`^words/`+existing.ID.Hex()+`/[0-9a-f]{24}\.png$`, link.Key)
+	suite.mockWords.AssertExpectations(suite.T())
+}
+
+func (suite *MediaServiceTestSuite) TestUpload_DeletesFileWhenAttachingFails() {
+	// Given
+	existing := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity"}
+	upload := &Upload{Filename: "picture.png", ContentType: "image/png", Size: int64(len(pngHeader)), Body: bytes.NewReader(pngHeader)}
+	suite.mockWords.EXPECT().FindWord(existing.ID.Hex()).Return(existing, nil)
+	suite.mockStorage.EXPECT().Put(mock.Anything, "image/png", mock.Anything, mock.Anything).Return(nil)
+	suite.mockWords.EXPECT().AddAttachment(existing.ID.Hex(), mock.Anything).Return(nil, word.ErrVersionConflict)
+	suite.mockStorage.EXPECT().Delete(mock.Anything).Return(nil)
+
+	// When
+	_, err := suite.service.Upload(existing.ID.Hex(), upload, "", time.Now())
+
+	// Then
+	suite.ErrorIs(err, word.ErrVersionConflict)
+	suite.mockStorage.AssertExpectations(suite.T())
+}
+
+func (suite *MediaServiceTestSuite) TestUpload_Validation() {
+	existing := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity"}
+	suite.mockWords.EXPECT().FindWord(existing.ID.Hex()).Return(existing, nil).Maybe()
+
+	tests := []struct {
+		name    string
+		upload  *Upload
+		wantErr error
+	}{
+		{
+			name:    "Unsupported Type",
+			upload:  &Upload{ContentType: "application/pdf", Size: 4, Body: bytes.NewReader([]byte("%PDF"))},
+			wantErr: ErrUnsupportedType,
+		},
+		{
+			name:    "Image Not Matching Its Type",
+			upload:  &Upload{ContentType: "image/jpeg", Size: int64(len(pngHeader)), Body: bytes.NewReader(pngHeader)},
+			wantErr: ErrUnsupportedType,
+		},
+		{
+			name:    "Audio That Is Text",
+			upload:  &Upload{ContentType: "audio/mpeg", Size: 14, Body: bytes.NewReader([]byte("<html></html>\n"))},
+			wantErr: ErrUnsupportedType,
+		},
+		{
+			name:    "Audio That Is An Archive",
+			upload:  &Upload{ContentType: "audio/mpeg", Size: 8, Body: bytes.NewReader([]byte("PK\x03\x04\x14\x00\x00\x00"))},
+			wantErr: ErrUnsupportedType,
+		},
+		{
+			name:    "Audio Of Another Type",
+			upload:  &Upload{ContentType: "audio/wav", Size: 8, Body: bytes.NewReader([]byte("OggS\x00\x02\x00\x00"))},
+			wantErr: ErrUnsupportedType,
+		},
+		{
+			name:    "Image Too Large",
+			upload:  &Upload{ContentType: "image/png", Size: MaxImageSize + 1, Body: bytes.NewReader(pngHeader)},
+			wantErr: ErrTooLarge,
+		},
+		{
+			name:    "Empty File",
+			upload:  &Upload{ContentType: "audio/mpeg", Size: 0, Body: bytes.NewReader(nil)},
+			wantErr: ErrEmptyFile,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			_, err := suite.service.Upload(existing.ID.Hex(), tt.upload, "", time.Now())
+			suite.ErrorIs(err, tt.wantErr)
+		})
+	}
+	suite.mockStorage.AssertNotCalled(suite.T(), "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestValidate_Audio(t *testing.T) {
+	for contentType, head := range map[string][]byte{
+		"audio/mpeg": {0xFF, 0xFB, 0x90, 0x64},
+		"audio/mp4":  []byte("\x00\x00\x00\x20ftypM4A "),
+		"audio/ogg":  []byte("OggS\x00\x02"),
+		"audio/wav":  []byte("RIFF\x24\x08\x00\x00WAVEfmt "),
+		"audio/webm": {0x1A, 0x45, 0xDF, 0xA3, 0x9F},
+	} {
+		_, _, err := validate(&Upload{ContentType: contentType, Size: int64(len(head)), Body: bytes.NewReader(head)})
+		assert.NoError(t, err, contentType)
+	}
+}
+
+func TestContentType(t *testing.T) {
+	for contentType, mediaType := range mediaTypes {
+		assert.Equal(t, contentType, ContentType("words/abc/def"+mediaType.extension), mediaType.extension)
+	}
+	assert.Equal(t, "application/octet-stream", ContentType("words/abc/def.exe"))
+}
+
+func (suite *MediaServiceTestSuite) TestUpload_DeletedWord() {
+	// Given
+	deleted := &word.Word{ID: primitive.NewObjectID(), Text: "deleted", DeletedAt: time.Now()}
+	upload := &Upload{ContentType: "image/png", Size: int64(len(pngHeader)), Body: bytes.NewReader(pngHeader)}
+	suite.mockWords.EXPECT().FindWord(deleted.ID.Hex()).Return(deleted, nil)
+
+	// When
+	_, err := suite.service.Upload(deleted.ID.Hex(), upload, "", time.Now())
+
+	// Then
+	suite.ErrorIs(err, word.ErrWordNotFound)
+}
+
+func (suite *MediaServiceTestSuite) TestRemove_DeletesFile() {
+	// Given
+	attachment := &word.Attachment{ID: "attachment-1", Key: "words/1/attachment-1.png"}
+	suite.mockWords.EXPECT().RemoveAttachment("word-1", "attachment-1").Return(attachment, nil)
+	suite.mockStorage.EXPECT().Delete("words/1/attachment-1.png").Return(errors.New("storage unavailable"))
+
+	// When
+	err := suite.service.Remove("word-1", "attachment-1")
+
+	// Then
+	suite.NoError(err, "Expected a file left behind not to fail the removal")
+	suite.mockStorage.AssertExpectations(suite.T())
+}
@@ -0,0 +1,14 @@
+package media
+
+import (
+	"io"
+	"time"
+)
+
+// Storage keeps uploaded files under the keys the service picks for them.
+type Storage interface {
+	Put(key, contentType string, body io.Reader, size int64) error
+	Delete(key string) error
+	// SignedURL returns a link anyone holding it can fetch the file with until expiresAt.
+	SignedURL(key string, expiresAt time.Time) (string, error)
+}
@@ -0,0 +1,11 @@
+package quiz
+
+import "errors"
+
+var (
+	ErrQuizNotFound   = errors.New("quiz not found")
+	ErrQuizMismatch   = errors.New("quiz does not belong to the subscription")
+	ErrInvalidAnswer  = errors.New("invalid quiz answer")
+	ErrNotEnoughWords = errors.New("not enough recently delivered words for a quiz")
+	ErrQuizNotDueYet  = errors.New("quiz already sent this week")
+)
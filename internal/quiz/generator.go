@@ -0,0 +1,76 @@
+package quiz
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// maxDistractors is the number of wrong choices offered next to the right meaning.
+const maxDistractors = 3
+
+// newQuiz builds a question for each of words, drawing wrong choices from the meanings of pool.
+// Words whose meaning cannot be told apart from enough distractors are left out.
+func newQuiz(subscriptionID primitive.ObjectID, words, pool []*word.Word, rng *rand.Rand, now time.Time) *Quiz {
+	// The other quiz words make good distractors too; a word's own meaning is skipped as a duplicate.
+	candidates := append(slices.Clone(pool), words...)
+	quiz := &Quiz{SubscriptionID: subscriptionID, CreatedAt: now}
+	for _, quizWord := range words {
+		if question := newQuestion(quizWord, candidates, rng); question != nil {
+			quiz.Questions = append(quiz.Questions, question)
+		}
+	}
+	return quiz
+}
+
+func newQuestion(quizWord *word.Word, pool []*word.Word, rng *rand.Rand) *Question {
+	answer := meaning(quizWord)
+	if answer == "" {
+		return nil
+	}
+
+	choices := []string{answer}
+	for _, i := range rng.Perm(len(pool)) {
+		if len(choices) > maxDistractors {
+			break
+		}
+		distractor := meaning(pool[i])
+		if distractor == "" || containsChoice(choices, distractor) {
+			continue
+		}
+		choices = append(choices, distractor)
+	}
+	if len(choices) < 3 {
+		return nil
+	}
+
+	rng.Shuffle(len(choices), func(i, j int) { choices[i], choices[j] = choices[j], choices[i] })
+	question := &Question{WordID: quizWord.ID, Prompt: quizWord.Text, Choices: choices}
+	for i, choice := range choices {
+		if choice == answer {
+			question.AnswerIndex = i
+		}
+	}
+	return question
+}
+
+// meaning is the text a word is recognised by in a quiz: its Korean meanings, or its English meaning when it has none.
+func meaning(w *word.Word) string {
+	if len(w.KoreanMeanings) > 0 {
+		return strings.Join(w.KoreanMeanings, ", ")
+	}
+	return strings.TrimSpace(w.EnglishMeaning)
+}
+
+func containsChoice(choices []string, choice string) bool {
+	for _, existing := range choices {
+		if strings.EqualFold(existing, choice) {
+			return true
+		}
+	}
+	return false
+}
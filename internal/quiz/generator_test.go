@@ -0,0 +1,83 @@
+package quiz
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNewQuiz(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	words := []*word.Word{
+		{ID: primitive.NewObjectID(), Text: "serendipity", KoreanMeanings: []string{"뜻밖의 행운", "우연한 발견"}},
+		{ID: primitive.NewObjectID(), Text: "resilience", EnglishMeaning: "the ability to recover quickly"},
+		{ID: primitive.NewObjectID(), Text: "blank"},
+	}
+	pool := []*word.Word{
+		{Text: "ephemeral", KoreanMeanings: []string{"덧없는"}},
+		{Text: "lucky", KoreanMeanings: []string{"뜻밖의 행운", "우연한 발견"}},
+		{Text: "ubiquitous", KoreanMeanings: []string{"어디에나 있는"}},
+	}
+
+	quiz := newQuiz(primitive.NewObjectID(), words, pool, rng, time.Now())
+
+	require.Len(t, quiz.Questions, 2, "A word without any meaning should be left out")
+	first := quiz.Questions[0]
+	assert.Equal(t, "serendipity", first.Prompt)
+	assert.Equal(t, "뜻밖의 행운, 우연한 발견", first.Choices[first.AnswerIndex])
+	assert.Len(t, first.Choices, 1+maxDistractors)
+	assert.ElementsMatch(t, []string{"뜻밖의 행운, 우연한 발견", "덧없는", "어디에나 있는", "the ability to recover quickly"}, first.Choices,
+		"Choices should be distinct, including meanings shared by other words")
+
+	second := quiz.Questions[1]
+	assert.Equal(t, "the ability to recover quickly", second.Choices[second.AnswerIndex])
+}
+
+func TestNewQuiz_NotEnoughDistractors(t *testing.T) {
+	words := []*word.Word{{Text: "serendipity", KoreanMeanings: []string{"뜻밖의 행운"}}}
+	pool := []*word.Word{{Text: "ephemeral", KoreanMeanings: []string{"덧없는"}}}
+
+	quiz := newQuiz(primitive.NewObjectID(), words, pool, rand.New(rand.NewPCG(1, 2)), time.Now())
+
+	assert.Empty(t, quiz.Questions)
+}
+
+func TestQuiz_Answer(t *testing.T) {
+	now := time.Now()
+	quiz := &Quiz{Questions: []*Question{
+		{Choices: []string{"a", "b", "c"}, AnswerIndex: 1},
+		{Choices: []string{"a", "b", "c"}, AnswerIndex: 0},
+	}}
+
+	t.Run("First answer counts", func(t *testing.T) {
+		recorded, err := quiz.answer(0, 1, now)
+		require.NoError(t, err)
+		assert.True(t, recorded)
+
+		recorded, err = quiz.answer(0, 2, now)
+		require.NoError(t, err)
+		assert.False(t, recorded)
+		assert.True(t, quiz.Questions[0].Correct())
+		assert.Equal(t, Score{Total: 2, Answered: 1, Correct: 1}, quiz.Score())
+	})
+
+	t.Run("Wrong answer", func(t *testing.T) {
+		_, err := quiz.answer(1, 2, now)
+		require.NoError(t, err)
+		assert.False(t, quiz.Questions[1].Correct())
+		assert.Equal(t, Score{Total: 2, Answered: 2, Correct: 1}, quiz.Score())
+	})
+
+	t.Run("Failure-Out of range", func(t *testing.T) {
+		_, err := quiz.answer(2, 0, now)
+		assert.ErrorIs(t, err, ErrInvalidAnswer)
+
+		_, err = quiz.answer(0, 3, now)
+		assert.ErrorIs(t, err, ErrInvalidAnswer)
+	})
+}
@@ -0,0 +1,775 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package quiz
+
+import (
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/word"
+	mock "github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// AnswerQuestion provides a mock function for the type MockRepository
+func (_mock *MockRepository) AnswerQuestion(quizID primitive.ObjectID, index int, choice int, answeredAt time.Time) (bool, error) {
+	ret := _mock.Called(quizID, index, choice, answeredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnswerQuestion")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, int, int, time.Time) (bool, error)); ok {
+		return returnFunc(quizID, index, choice, answeredAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, int, int, time.Time) bool); ok {
+		r0 = returnFunc(quizID, index, choice, answeredAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID, int, int, time.Time) error); ok {
+		r1 = returnFunc(quizID, index, choice, answeredAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_AnswerQuestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AnswerQuestion'
+type MockRepository_AnswerQuestion_Call struct {
+	*mock.Call
+}
+
+// AnswerQuestion is a helper method to define mock.On call
+//   - quizID primitive.ObjectID
+//   - index int
+//   - choice int
+//   - answeredAt time.Time
+func (_e *MockRepository_Expecter) AnswerQuestion(quizID interface{}, index interface{}, choice interface{}, answeredAt interface{}) *MockRepository_AnswerQuestion_Call {
+	return &MockRepository_AnswerQuestion_Call{Call: _e.mock.On("AnswerQuestion", quizID, index, choice, answeredAt)}
+}
+
+func (_c *MockRepository_AnswerQuestion_Call) Run(run func(quizID primitive.ObjectID, index int, choice int, answeredAt time.Time)) *MockRepository_AnswerQuestion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_AnswerQuestion_Call) Return(b bool, err error) *MockRepository_AnswerQuestion_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockRepository_AnswerQuestion_Call) RunAndReturn(run func(quizID primitive.ObjectID, index int, choice int, answeredAt time.Time) (bool, error)) *MockRepository_AnswerQuestion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteQuiz provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteQuiz(id primitive.ObjectID) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuiz")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteQuiz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteQuiz'
+type MockRepository_DeleteQuiz_Call struct {
+	*mock.Call
+}
+
+// DeleteQuiz is a helper method to define mock.On call
+//   - id primitive.ObjectID
+func (_e *MockRepository_Expecter) DeleteQuiz(id interface{}) *MockRepository_DeleteQuiz_Call {
+	return &MockRepository_DeleteQuiz_Call{Call: _e.mock.On("DeleteQuiz", id)}
+}
+
+func (_c *MockRepository_DeleteQuiz_Call) Run(run func(id primitive.ObjectID)) *MockRepository_DeleteQuiz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteQuiz_Call) Return(err error) *MockRepository_DeleteQuiz_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteQuiz_Call) RunAndReturn(run func(id primitive.ObjectID) error) *MockRepository_DeleteQuiz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindById provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindById(id string) (*Quiz, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *Quiz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*Quiz, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *Quiz); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Quiz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - id string
+func (_e *MockRepository_Expecter) FindById(id interface{}) *MockRepository_FindById_Call {
+	return &MockRepository_FindById_Call{Call: _e.mock.On("FindById", id)}
+}
+
+func (_c *MockRepository_FindById_Call) Run(run func(id string)) *MockRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindById_Call) Return(quiz *Quiz, err error) *MockRepository_FindById_Call {
+	_c.Call.Return(quiz, err)
+	return _c
+}
+
+func (_c *MockRepository_FindById_Call) RunAndReturn(run func(id string) (*Quiz, error)) *MockRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLatest provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindLatest(subscriptionID primitive.ObjectID) (*Quiz, error) {
+	ret := _mock.Called(subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLatest")
+	}
+
+	var r0 *Quiz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) (*Quiz, error)); ok {
+		return returnFunc(subscriptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) *Quiz); ok {
+		r0 = returnFunc(subscriptionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Quiz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = returnFunc(subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLatest'
+type MockRepository_FindLatest_Call struct {
+	*mock.Call
+}
+
+// FindLatest is a helper method to define mock.On call
+//   - subscriptionID primitive.ObjectID
+func (_e *MockRepository_Expecter) FindLatest(subscriptionID interface{}) *MockRepository_FindLatest_Call {
+	return &MockRepository_FindLatest_Call{Call: _e.mock.On("FindLatest", subscriptionID)}
+}
+
+func (_c *MockRepository_FindLatest_Call) Run(run func(subscriptionID primitive.ObjectID)) *MockRepository_FindLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindLatest_Call) Return(quiz *Quiz, err error) *MockRepository_FindLatest_Call {
+	_c.Call.Return(quiz, err)
+	return _c
+}
+
+func (_c *MockRepository_FindLatest_Call) RunAndReturn(run func(subscriptionID primitive.ObjectID) (*Quiz, error)) *MockRepository_FindLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveQuiz provides a mock function for the type MockRepository
+func (_mock *MockRepository) SaveQuiz(quiz *Quiz) (*Quiz, error) {
+	ret := _mock.Called(quiz)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQuiz")
+	}
+
+	var r0 *Quiz
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*Quiz) (*Quiz, error)); ok {
+		return returnFunc(quiz)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*Quiz) *Quiz); ok {
+		r0 = returnFunc(quiz)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Quiz)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*Quiz) error); ok {
+		r1 = returnFunc(quiz)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_SaveQuiz_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveQuiz'
+type MockRepository_SaveQuiz_Call struct {
+	*mock.Call
+}
+
+// SaveQuiz is a helper method to define mock.On call
+//   - quiz *Quiz
+func (_e *MockRepository_Expecter) SaveQuiz(quiz interface{}) *MockRepository_SaveQuiz_Call {
+	return &MockRepository_SaveQuiz_Call{Call: _e.mock.On("SaveQuiz", quiz)}
+}
+
+func (_c *MockRepository_SaveQuiz_Call) Run(run func(quiz *Quiz)) *MockRepository_SaveQuiz_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Quiz
+		if args[0] != nil {
+			arg0 = args[0].(*Quiz)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveQuiz_Call) Return(quiz1 *Quiz, err error) *MockRepository_SaveQuiz_Call {
+	_c.Call.Return(quiz1, err)
+	return _c
+}
+
+func (_c *MockRepository_SaveQuiz_Call) RunAndReturn(run func(quiz *Quiz) (*Quiz, error)) *MockRepository_SaveQuiz_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSubscriptions creates a new instance of MockSubscriptions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSubscriptions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSubscriptions {
+	mock := &MockSubscriptions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSubscriptions is an autogenerated mock type for the Subscriptions type
+type MockSubscriptions struct {
+	mock.Mock
+}
+
+type MockSubscriptions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSubscriptions) EXPECT() *MockSubscriptions_Expecter {
+	return &MockSubscriptions_Expecter{mock: &_m.Mock}
+}
+
+// FindDeliverableSubscriptions provides a mock function for the type MockSubscriptions
+func (_mock *MockSubscriptions) FindDeliverableSubscriptions() ([]*subscription.Subscription, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDeliverableSubscriptions")
+	}
+
+	var r0 []*subscription.Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*subscription.Subscription, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*subscription.Subscription); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*subscription.Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptions_FindDeliverableSubscriptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeliverableSubscriptions'
+type MockSubscriptions_FindDeliverableSubscriptions_Call struct {
+	*mock.Call
+}
+
+// FindDeliverableSubscriptions is a helper method to define mock.On call
+func (_e *MockSubscriptions_Expecter) FindDeliverableSubscriptions() *MockSubscriptions_FindDeliverableSubscriptions_Call {
+	return &MockSubscriptions_FindDeliverableSubscriptions_Call{Call: _e.mock.On("FindDeliverableSubscriptions")}
+}
+
+func (_c *MockSubscriptions_FindDeliverableSubscriptions_Call) Run(run func()) *MockSubscriptions_FindDeliverableSubscriptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSubscriptions_FindDeliverableSubscriptions_Call) Return(subscriptions []*subscription.Subscription, err error) *MockSubscriptions_FindDeliverableSubscriptions_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockSubscriptions_FindDeliverableSubscriptions_Call) RunAndReturn(run func() ([]*subscription.Subscription, error)) *MockSubscriptions_FindDeliverableSubscriptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockDeliveries creates a new instance of MockDeliveries. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDeliveries(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDeliveries {
+	mock := &MockDeliveries{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDeliveries is an autogenerated mock type for the Deliveries type
+type MockDeliveries struct {
+	mock.Mock
+}
+
+type MockDeliveries_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDeliveries) EXPECT() *MockDeliveries_Expecter {
+	return &MockDeliveries_Expecter{mock: &_m.Mock}
+}
+
+// DeliveredWordIDs provides a mock function for the type MockDeliveries
+func (_mock *MockDeliveries) DeliveredWordIDs(subscriptionID primitive.ObjectID, since time.Time) ([]primitive.ObjectID, error) {
+	ret := _mock.Called(subscriptionID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliveredWordIDs")
+	}
+
+	var r0 []primitive.ObjectID
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, time.Time) ([]primitive.ObjectID, error)); ok {
+		return returnFunc(subscriptionID, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, time.Time) []primitive.ObjectID); ok {
+		r0 = returnFunc(subscriptionID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]primitive.ObjectID)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID, time.Time) error); ok {
+		r1 = returnFunc(subscriptionID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDeliveries_DeliveredWordIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliveredWordIDs'
+type MockDeliveries_DeliveredWordIDs_Call struct {
+	*mock.Call
+}
+
+// DeliveredWordIDs is a helper method to define mock.On call
+//   - subscriptionID primitive.ObjectID
+//   - since time.Time
+func (_e *MockDeliveries_Expecter) DeliveredWordIDs(subscriptionID interface{}, since interface{}) *MockDeliveries_DeliveredWordIDs_Call {
+	return &MockDeliveries_DeliveredWordIDs_Call{Call: _e.mock.On("DeliveredWordIDs", subscriptionID, since)}
+}
+
+func (_c *MockDeliveries_DeliveredWordIDs_Call) Run(run func(subscriptionID primitive.ObjectID, since time.Time)) *MockDeliveries_DeliveredWordIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDeliveries_DeliveredWordIDs_Call) Return(objectIDs []primitive.ObjectID, err error) *MockDeliveries_DeliveredWordIDs_Call {
+	_c.Call.Return(objectIDs, err)
+	return _c
+}
+
+func (_c *MockDeliveries_DeliveredWordIDs_Call) RunAndReturn(run func(subscriptionID primitive.ObjectID, since time.Time) ([]primitive.ObjectID, error)) *MockDeliveries_DeliveredWordIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWords creates a new instance of MockWords. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWords(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWords {
+	mock := &MockWords{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWords is an autogenerated mock type for the Words type
+type MockWords struct {
+	mock.Mock
+}
+
+type MockWords_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWords) EXPECT() *MockWords_Expecter {
+	return &MockWords_Expecter{mock: &_m.Mock}
+}
+
+// FindWord provides a mock function for the type MockWords
+func (_mock *MockWords) FindWord(id string) (*word.Word, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWord")
+	}
+
+	var r0 *word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*word.Word, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *word.Word); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_FindWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWord'
+type MockWords_FindWord_Call struct {
+	*mock.Call
+}
+
+// FindWord is a helper method to define mock.On call
+//   - id string
+func (_e *MockWords_Expecter) FindWord(id interface{}) *MockWords_FindWord_Call {
+	return &MockWords_FindWord_Call{Call: _e.mock.On("FindWord", id)}
+}
+
+func (_c *MockWords_FindWord_Call) Run(run func(id string)) *MockWords_FindWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) Return(word1 *word.Word, err error) *MockWords_FindWord_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) RunAndReturn(run func(id string) (*word.Word, error)) *MockWords_FindWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SampleWords provides a mock function for the type MockWords
+func (_mock *MockWords) SampleWords(size int, excludeIDs []primitive.ObjectID) ([]*word.Word, error) {
+	ret := _mock.Called(size, excludeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SampleWords")
+	}
+
+	var r0 []*word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, []primitive.ObjectID) ([]*word.Word, error)); ok {
+		return returnFunc(size, excludeIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, []primitive.ObjectID) []*word.Word); ok {
+		r0 = returnFunc(size, excludeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, []primitive.ObjectID) error); ok {
+		r1 = returnFunc(size, excludeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_SampleWords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SampleWords'
+type MockWords_SampleWords_Call struct {
+	*mock.Call
+}
+
+// SampleWords is a helper method to define mock.On call
+//   - size int
+//   - excludeIDs []primitive.ObjectID
+func (_e *MockWords_Expecter) SampleWords(size interface{}, excludeIDs interface{}) *MockWords_SampleWords_Call {
+	return &MockWords_SampleWords_Call{Call: _e.mock.On("SampleWords", size, excludeIDs)}
+}
+
+func (_c *MockWords_SampleWords_Call) Run(run func(size int, excludeIDs []primitive.ObjectID)) *MockWords_SampleWords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 []primitive.ObjectID
+		if args[1] != nil {
+			arg1 = args[1].([]primitive.ObjectID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_SampleWords_Call) Return(words []*word.Word, err error) *MockWords_SampleWords_Call {
+	_c.Call.Return(words, err)
+	return _c
+}
+
+func (_c *MockWords_SampleWords_Call) RunAndReturn(run func(size int, excludeIDs []primitive.ObjectID) ([]*word.Word, error)) *MockWords_SampleWords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMailSender creates a new instance of MockMailSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMailSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMailSender {
+	mock := &MockMailSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockMailSender is an autogenerated mock type for the MailSender type
+type MockMailSender struct {
+	mock.Mock
+}
+
+type MockMailSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMailSender) EXPECT() *MockMailSender_Expecter {
+	return &MockMailSender_Expecter{mock: &_m.Mock}
+}
+
+// SendQuizEmail provides a mock function for the type MockMailSender
+func (_mock *MockMailSender) SendQuizEmail(recipient *email.Recipient, questions []email.QuizQuestion) error {
+	ret := _mock.Called(recipient, questions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendQuizEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*email.Recipient, []email.QuizQuestion) error); ok {
+		r0 = returnFunc(recipient, questions)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMailSender_SendQuizEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendQuizEmail'
+type MockMailSender_SendQuizEmail_Call struct {
+	*mock.Call
+}
+
+// SendQuizEmail is a helper method to define mock.On call
+//   - recipient *email.Recipient
+//   - questions []email.QuizQuestion
+func (_e *MockMailSender_Expecter) SendQuizEmail(recipient interface{}, questions interface{}) *MockMailSender_SendQuizEmail_Call {
+	return &MockMailSender_SendQuizEmail_Call{Call: _e.mock.On("SendQuizEmail", recipient, questions)}
+}
+
+func (_c *MockMailSender_SendQuizEmail_Call) Run(run func(recipient *email.Recipient, questions []email.QuizQuestion)) *MockMailSender_SendQuizEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *email.Recipient
+		if args[0] != nil {
+			arg0 = args[0].(*email.Recipient)
+		}
+		var arg1 []email.QuizQuestion
+		if args[1] != nil {
+			arg1 = args[1].([]email.QuizQuestion)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMailSender_SendQuizEmail_Call) Return(err error) *MockMailSender_SendQuizEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMailSender_SendQuizEmail_Call) RunAndReturn(run func(recipient *email.Recipient, questions []email.QuizQuestion) error) *MockMailSender_SendQuizEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,72 @@
+package quiz
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Quiz is one weekly multiple-choice quiz sent to a subscriber.
+type Quiz struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	SubscriptionID primitive.ObjectID `bson:"subscription_id"`
+	Questions      []*Question        `bson:"questions"`
+	CreatedAt      time.Time          `bson:"created_at"`
+}
+
+// Question asks for the meaning of a recently delivered word.
+type Question struct {
+	WordID      primitive.ObjectID `bson:"word_id"`
+	Prompt      string             `bson:"prompt"`
+	Choices     []string           `bson:"choices"`
+	AnswerIndex int                `bson:"answer_index"`
+	ChosenIndex int                `bson:"chosen_index"`
+	AnsweredAt  time.Time          `bson:"answered_at,omitempty"`
+}
+
+func (q *Question) Answered() bool {
+	return !q.AnsweredAt.IsZero()
+}
+
+func (q *Question) Correct() bool {
+	return q.Answered() && q.ChosenIndex == q.AnswerIndex
+}
+
+// Score summarises how a subscriber has done on a quiz so far.
+type Score struct {
+	Total    int `json:"total"`
+	Answered int `json:"answered"`
+	Correct  int `json:"correct"`
+}
+
+func (q *Quiz) Score() Score {
+	score := Score{Total: len(q.Questions)}
+	for _, question := range q.Questions {
+		if question.Answered() {
+			score.Answered++
+		}
+		if question.Correct() {
+			score.Correct++
+		}
+	}
+	return score
+}
+
+// answer records choice for the question at index. Only the first answer to a question counts,
+// so it reports false when the question had already been answered.
+func (q *Quiz) answer(index, choice int, now time.Time) (bool, error) {
+	if index < 0 || index >= len(q.Questions) {
+		return false, ErrInvalidAnswer
+	}
+	question := q.Questions[index]
+	if choice < 0 || choice >= len(question.Choices) {
+		return false, ErrInvalidAnswer
+	}
+	if question.Answered() {
+		return false, nil
+	}
+
+	question.ChosenIndex = choice
+	question.AnsweredAt = now
+	return true, nil
+}
@@ -0,0 +1,96 @@
+package quiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const collectionName = "quizzes"
+
+type MongoRepository struct {
+	collection *mongo.Collection
+}
+
+func NewQuizRepo(db *mongo.Database) *MongoRepository {
+	return &MongoRepository{
+		collection: db.Collection(collectionName),
+	}
+}
+
+func (r *MongoRepository) SaveQuiz(quiz *Quiz) (*Quiz, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := r.collection.InsertOne(ctx, quiz)
+	if err != nil {
+		return nil, err
+	}
+
+	quiz.ID = result.InsertedID.(primitive.ObjectID)
+	return quiz, nil
+}
+
+func (r *MongoRepository) FindById(id string) (*Quiz, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	return decodeQuiz(r.collection.FindOne(ctx, bson.M{"_id": objectID}))
+}
+
+// FindLatest returns the most recent quiz sent to the subscription.
+func (r *MongoRepository) FindLatest(subscriptionID primitive.ObjectID) (*Quiz, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	findOptions := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	return decodeQuiz(r.collection.FindOne(ctx, bson.M{"subscription_id": subscriptionID}, findOptions))
+}
+
+// AnswerQuestion stores choice as the answer to the question at index, unless the question was answered already,
+// in which case it returns false. The check and the write are one update, so of two answers clicked at nearly the
+// same time only the first counts.
+func (r *MongoRepository) AnswerQuestion(quizID primitive.ObjectID, index, choice int, answeredAt time.Time) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	question := fmt.Sprintf("questions.%d", index)
+	filter := bson.M{"_id": quizID, question + ".answered_at": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{question + ".chosen_index": choice, question + ".answered_at": answeredAt}}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+func (r *MongoRepository) DeleteQuiz(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
+
+func decodeQuiz(result *mongo.SingleResult) (*Quiz, error) {
+	if errors.Is(result.Err(), mongo.ErrNoDocuments) {
+		return nil, ErrQuizNotFound
+	}
+
+	quiz := &Quiz{}
+	if err := result.Decode(quiz); err != nil {
+		return nil, fmt.Errorf("failed to decode quiz: %w", err)
+	}
+	return quiz, nil
+}
@@ -0,0 +1,82 @@
+package quiz
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/infra/testhelper"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QuizRepoTestSuite struct {
+	suite.Suite
+	database *testhelper.TestDatabase
+	repo     *MongoRepository
+}
+
+func (suite *QuizRepoTestSuite) SetupSuite() {
+	log.Println("Setting up QuizRepoTestSuite...")
+	suite.database = testhelper.SetupTestDatabase()
+	suite.repo = NewQuizRepo(suite.database.DbInstance)
+}
+
+func (suite *QuizRepoTestSuite) TearDownSuite() {
+	log.Println("Tearing down QuizRepoTestSuite...")
+	suite.database.TearDown()
+}
+
+func (suite *QuizRepoTestSuite) BeforeTest(suiteName, testName string) {
+	log.Printf("Before test: %s - %s\n", suiteName, testName)
+	if err := suite.database.CleanUp(); err != nil {
+		log.Fatalf("Failed to clean up database before test: %v", err)
+	}
+}
+
+func TestQuizRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(QuizRepoTestSuite))
+}
+
+func (suite *QuizRepoTestSuite) TestQuizRepository_FindLatest() {
+	suite.Run("Latest quiz of the subscription", func() {
+		subscriptionID := primitive.NewObjectID()
+		now := time.Now().Truncate(time.Millisecond)
+
+		_, err := suite.repo.FindLatest(subscriptionID)
+		suite.ErrorIs(err, ErrQuizNotFound)
+
+		_, _ = suite.repo.SaveQuiz(&Quiz{SubscriptionID: subscriptionID, CreatedAt: now.AddDate(0, 0, -7)})
+		latest, err := suite.repo.SaveQuiz(&Quiz{ID: primitive.NewObjectID(), SubscriptionID: subscriptionID, CreatedAt: now})
+		suite.NoError(err, "Expected no error when saving a quiz")
+
+		found, err := suite.repo.FindLatest(subscriptionID)
+		suite.NoError(err, "Expected no error when finding the latest quiz")
+		suite.Equal(latest.ID, found.ID)
+	})
+}
+
+func (suite *QuizRepoTestSuite) TestQuizRepository_AnswerQuestion() {
+	suite.Run("Only the first answer is stored", func() {
+		quiz, _ := suite.repo.SaveQuiz(&Quiz{
+			SubscriptionID: primitive.NewObjectID(),
+			Questions: []*Question{
+				{Prompt: "serendipity", Choices: []string{"a", "b", "c"}, AnswerIndex: 1},
+				{Prompt: "ephemeral", Choices: []string{"a", "b", "c"}, AnswerIndex: 0},
+			},
+			CreatedAt: time.Now(),
+		})
+
+		stored, err := suite.repo.AnswerQuestion(quiz.ID, 0, 1, time.Now())
+		suite.NoError(err, "Expected no error when answering a question")
+		suite.True(stored)
+		stored, err = suite.repo.AnswerQuestion(quiz.ID, 0, 2, time.Now())
+		suite.NoError(err)
+		suite.False(stored, "Expected a second answer not to be stored")
+
+		found, err := suite.repo.FindById(quiz.ID.Hex())
+		suite.NoError(err)
+		suite.True(found.Questions[0].Correct())
+		suite.False(found.Questions[1].Answered())
+	})
+}
@@ -0,0 +1,234 @@
+package quiz
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/auth"
+	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// quizInterval is both how often a subscriber gets a quiz and how far back its words are taken from.
+	quizInterval = 7 * 24 * time.Hour
+	minQuestions = 3
+	maxQuestions = 10
+	// distractorPoolSize is how many random words wrong choices are drawn from.
+	distractorPoolSize = 30
+)
+
+type Repository interface {
+	SaveQuiz(quiz *Quiz) (*Quiz, error)
+	FindById(id string) (*Quiz, error)
+	FindLatest(subscriptionID primitive.ObjectID) (*Quiz, error)
+	AnswerQuestion(quizID primitive.ObjectID, index, choice int, answeredAt time.Time) (bool, error)
+	DeleteQuiz(id primitive.ObjectID) error
+}
+
+type Subscriptions interface {
+	FindDeliverableSubscriptions() ([]*subscription.Subscription, error)
+}
+
+type Deliveries interface {
+	DeliveredWordIDs(subscriptionID primitive.ObjectID, since time.Time) ([]primitive.ObjectID, error)
+}
+
+type Words interface {
+	FindWord(id string) (*word.Word, error)
+	SampleWords(size int, excludeIDs []primitive.ObjectID) ([]*word.Word, error)
+}
+
+type MailSender interface {
+	SendQuizEmail(recipient *email.Recipient, questions []email.QuizQuestion) error
+}
+
+type Service struct {
+	repository    Repository
+	subscriptions Subscriptions
+	deliveries    Deliveries
+	words         Words
+	mailSender    MailSender
+	jwtProvider   *auth.JwtProvider
+	rng           *rand.Rand
+}
+
+func NewQuizService(
+	repo Repository,
+	subscriptions Subscriptions,
+	deliveries Deliveries,
+	words Words,
+	mailSender MailSender,
+	provider *auth.JwtProvider,
+) *Service {
+	return &Service{
+		repository:    repo,
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+		words:         words,
+		mailSender:    mailSender,
+		jwtProvider:   provider,
+		rng:           rand.New(rand.NewPCG(uint64(time.Now().UnixNano()), 0)),
+	}
+}
+
+// AnswerResult is a recorded quiz answer together with the score of the whole quiz.
+type AnswerResult struct {
+	Question *Question
+	Score    Score
+}
+
+// SendWeeklyQuizzes mails a quiz on the past week's words to every subscriber who is not pausing their mail,
+// has not had a quiz in the last week and received enough words to ask about. It returns the number of quizzes sent.
+func (s *Service) SendWeeklyQuizzes(now time.Time) (int, error) {
+	subscriptions, err := s.subscriptions.FindDeliverableSubscriptions()
+	if err != nil {
+		return 0, fmt.Errorf("failed to find subscriptions: %w", err)
+	}
+
+	sent := 0
+	for _, sub := range subscriptions {
+		if sub.IsPausedAt(now) {
+			continue
+		}
+		err := s.sendQuiz(sub, now)
+		if errors.Is(err, ErrQuizNotDueYet) || errors.Is(err, ErrNotEnoughWords) {
+			continue
+		}
+		if err != nil {
+			log.Printf("Failed to send quiz to %s: %v", sub.Email, err)
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// RecordAnswer records the choice behind a quiz answer link. Only the first answer to a question counts.
+func (s *Service) RecordAnswer(token string, now time.Time) (*AnswerResult, error) {
+	claims, err := s.jwtProvider.ParseQuizToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse quiz token: %w", err)
+	}
+
+	quiz, err := s.repository.FindById(claims.QuizID)
+	if err != nil {
+		return nil, err
+	}
+	if quiz.SubscriptionID.Hex() != claims.SubscriptionID {
+		return nil, ErrQuizMismatch
+	}
+
+	recorded, err := quiz.answer(claims.Question, claims.Choice, now)
+	if err != nil {
+		return nil, err
+	}
+	if recorded {
+		stored, err := s.repository.AnswerQuestion(quiz.ID, claims.Question, claims.Choice, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record answer: %w", err)
+		}
+		if !stored {
+			// Another answer to the question got in first, and it is the one that counts.
+			if quiz, err = s.repository.FindById(claims.QuizID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &AnswerResult{Question: quiz.Questions[claims.Question], Score: quiz.Score()}, nil
+}
+
+func (s *Service) sendQuiz(sub *subscription.Subscription, now time.Time) error {
+	latest, err := s.repository.FindLatest(sub.ID)
+	if err != nil && !errors.Is(err, ErrQuizNotFound) {
+		return fmt.Errorf("failed to find latest quiz: %w", err)
+	}
+	if latest != nil && now.Sub(latest.CreatedAt) < quizInterval {
+		return ErrQuizNotDueYet
+	}
+
+	wordIDs, err := s.deliveries.DeliveredWordIDs(sub.ID, now.Add(-quizInterval))
+	if err != nil {
+		return fmt.Errorf("failed to find delivered words: %w", err)
+	}
+	if len(wordIDs) < minQuestions {
+		return ErrNotEnoughWords
+	}
+	s.rng.Shuffle(len(wordIDs), func(i, j int) { wordIDs[i], wordIDs[j] = wordIDs[j], wordIDs[i] })
+
+	quizWords := make([]*word.Word, 0, maxQuestions)
+	for _, wordID := range wordIDs {
+		if len(quizWords) == maxQuestions {
+			break
+		}
+		quizWord, err := s.words.FindWord(wordID.Hex())
+		if err != nil {
+			log.Printf("Leaving missing word %s out of quiz: %v", wordID.Hex(), err)
+			continue
+		}
+		if quizWord.IsDeleted() {
+			continue
+		}
+		quizWords = append(quizWords, quizWord)
+	}
+
+	pool, err := s.words.SampleWords(distractorPoolSize, wordIDs)
+	if err != nil {
+		return fmt.Errorf("failed to sample distractors: %w", err)
+	}
+
+	quiz := newQuiz(sub.ID, quizWords, pool, s.rng, now)
+	if len(quiz.Questions) < minQuestions {
+		return ErrNotEnoughWords
+	}
+	// The ID is needed for the answer links, which are signed before the quiz is stored.
+	quiz.ID = primitive.NewObjectID()
+
+	questions, err := s.emailQuestions(quiz)
+	if err != nil {
+		return err
+	}
+	// Stored before it is mailed, so that its answer links never lead to a quiz that does not exist.
+	if _, err := s.repository.SaveQuiz(quiz); err != nil {
+		return fmt.Errorf("failed to save quiz: %w", err)
+	}
+	recipient := &email.Recipient{SubscriptionID: sub.ID.Hex(), Email: sub.Email, Username: sub.Username}
+	if err := s.mailSender.SendQuizEmail(recipient, questions); err != nil {
+		// Nobody got the quiz, so it must not count as this week's: the next run sends a new one.
+		if err := s.repository.DeleteQuiz(quiz.ID); err != nil {
+			log.Printf("Failed to delete unsent quiz %s: %v", quiz.ID.Hex(), err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Service) emailQuestions(quiz *Quiz) ([]email.QuizQuestion, error) {
+	questions := make([]email.QuizQuestion, 0, len(quiz.Questions))
+	for i, question := range quiz.Questions {
+		choices := make([]email.QuizChoice, 0, len(question.Choices))
+		for j, choice := range question.Choices {
+			link, err := s.answerURL(quiz, i, j)
+			if err != nil {
+				return nil, err
+			}
+			choices = append(choices, email.QuizChoice{Text: choice, Link: link})
+		}
+		questions = append(questions, email.QuizQuestion{Prompt: question.Prompt, Choices: choices})
+	}
+	return questions, nil
+}
+
+func (s *Service) answerURL(quiz *Quiz, question, choice int) (string, error) {
+	token, err := s.jwtProvider.GenerateQuizToken(quiz.SubscriptionID.Hex(), quiz.ID.Hex(), question, choice)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate quiz token: %w", err)
+	}
+	return fmt.Sprintf("%s/quizzes/answer/%s", os.Getenv("APP_BASE_URL"), token), nil
+}
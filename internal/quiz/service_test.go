@@ -0,0 +1,264 @@
+package quiz
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/auth"
+	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type QuizServiceTestSuite struct {
+	suite.Suite
+	mockRepo          *MockRepository
+	mockSubscriptions *MockSubscriptions
+	mockDeliveries    *MockDeliveries
+	mockWords         *MockWords
+	mockMailSender    *MockMailSender
+	provider          *auth.JwtProvider
+	service           *Service
+}
+
+func (suite *QuizServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockRepository)
+	suite.mockSubscriptions = new(MockSubscriptions)
+	suite.mockDeliveries = new(MockDeliveries)
+	suite.mockWords = new(MockWords)
+	suite.mockMailSender = new(MockMailSender)
+	provider, _ := auth.NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	suite.provider = provider
+	suite.service = NewQuizService(suite.mockRepo, suite.mockSubscriptions, suite.mockDeliveries, suite.mockWords, suite.mockMailSender, provider)
+}
+
+func TestQuizServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(QuizServiceTestSuite))
+}
+
+func quizWords() []*word.Word {
+	return []*word.Word{
+		{ID: primitive.NewObjectID(), Text: "serendipity", KoreanMeanings: []string{"뜻밖의 행운"}},
+		{ID: primitive.NewObjectID(), Text: "ephemeral", KoreanMeanings: []string{"덧없는"}},
+		{ID: primitive.NewObjectID(), Text: "ubiquitous", KoreanMeanings: []string{"어디에나 있는"}},
+	}
+}
+
+func (suite *QuizServiceTestSuite) TestSendWeeklyQuizzes_Success() {
+	// Given
+	now := time.Date(2025, 8, 10, 9, 0, 0, 0, time.UTC)
+	sub := &subscription.Subscription{ID: primitive.NewObjectID(), Email: "user@example.com", Username: "tester"}
+	words := quizWords()
+	wordIDs := []primitive.ObjectID{words[0].ID, words[1].ID, words[2].ID}
+
+	suite.mockSubscriptions.EXPECT().FindDeliverableSubscriptions().Return([]*subscription.Subscription{sub}, nil)
+	suite.mockRepo.EXPECT().FindLatest(sub.ID).Return(nil, ErrQuizNotFound)
+	suite.mockDeliveries.EXPECT().DeliveredWordIDs(sub.ID, now.Add(-quizInterval)).Return(wordIDs, nil)
+	for _, w := range words {
+		suite.mockWords.EXPECT().FindWord(w.ID.Hex()).Return(w, nil)
+	}
+	suite.mockWords.EXPECT().SampleWords(distractorPoolSize, mock.Anything).Return([]*word.Word{
+		{Text: "resilience", KoreanMeanings: []string{"회복력"}},
+	}, nil)
+	suite.mockMailSender.EXPECT().SendQuizEmail(
+		mock.MatchedBy(func(recipient *email.Recipient) bool { return recipient.Email == sub.Email }),
+		mock.MatchedBy(func(questions []email.QuizQuestion) bool {
+			return len(questions) == 3 && len(questions[0].Choices) == 4 &&
+				strings.Contains(questions[0].Choices[0].Link, "/quizzes/answer/")
+		}),
+	).Return(nil)
+	suite.mockRepo.EXPECT().SaveQuiz(mock.MatchedBy(func(quiz *Quiz) bool {
+		return !quiz.ID.IsZero() && quiz.SubscriptionID == sub.ID && len(quiz.Questions) == 3
+	})).RunAndReturn(func(quiz *Quiz) (*Quiz, error) { return quiz, nil })
+
+	// When
+	sent, err := suite.service.SendWeeklyQuizzes(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(1, sent)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *QuizServiceTestSuite) TestSendWeeklyQuizzes_LeavesOutDeletedWords() {
+	// Given
+	now := time.Date(2025, 8, 10, 9, 0, 0, 0, time.UTC)
+	sub := &subscription.Subscription{ID: primitive.NewObjectID(), Email: "user@example.com", Username: "tester"}
+	words := append(quizWords(), &word.Word{ID: primitive.NewObjectID(), Text: "obsolete", KoreanMeanings: []string{"쓸모없는"}, DeletedAt: now})
+	wordIDs := []primitive.ObjectID{words[0].ID, words[1].ID, words[2].ID, words[3].ID}
+
+	suite.mockSubscriptions.EXPECT().FindDeliverableSubscriptions().Return([]*subscription.Subscription{sub}, nil)
+	suite.mockRepo.EXPECT().FindLatest(sub.ID).Return(nil, ErrQuizNotFound)
+	suite.mockDeliveries.EXPECT().DeliveredWordIDs(sub.ID, now.Add(-quizInterval)).Return(wordIDs, nil)
+	for _, w := range words {
+		suite.mockWords.EXPECT().FindWord(w.ID.Hex()).Return(w, nil)
+	}
+	suite.mockWords.EXPECT().SampleWords(distractorPoolSize, mock.Anything).Return([]*word.Word{
+		{Text: "resilience", KoreanMeanings: []string{"회복력"}},
+	}, nil)
+	suite.mockRepo.EXPECT().SaveQuiz(mock.Anything).RunAndReturn(func(quiz *Quiz) (*Quiz, error) { return quiz, nil })
+	suite.mockMailSender.EXPECT().SendQuizEmail(mock.Anything, mock.MatchedBy(func(questions []email.QuizQuestion) bool {
+		return len(questions) == 3 && !slices.ContainsFunc(questions, func(question email.QuizQuestion) bool {
+			return question.Prompt == "obsolete"
+		})
+	})).Return(nil)
+
+	// When
+	sent, err := suite.service.SendWeeklyQuizzes(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(1, sent)
+	suite.mockMailSender.AssertExpectations(suite.T())
+}
+
+func (suite *QuizServiceTestSuite) TestSendWeeklyQuizzes_Skips() {
+	// Given
+	now := time.Date(2025, 8, 10, 9, 0, 0, 0, time.UTC)
+	recentlyQuizzed := &subscription.Subscription{ID: primitive.NewObjectID()}
+	fewWords := &subscription.Subscription{ID: primitive.NewObjectID()}
+	paused := &subscription.Subscription{ID: primitive.NewObjectID()}
+	paused.Preferences.PausedFrom = "2025-08-01"
+
+	suite.mockSubscriptions.EXPECT().FindDeliverableSubscriptions().Return([]*subscription.Subscription{recentlyQuizzed, fewWords, paused}, nil)
+	suite.mockRepo.EXPECT().FindLatest(recentlyQuizzed.ID).Return(&Quiz{CreatedAt: now.AddDate(0, 0, -3)}, nil)
+	suite.mockRepo.EXPECT().FindLatest(fewWords.ID).Return(&Quiz{CreatedAt: now.AddDate(0, 0, -7)}, nil)
+	suite.mockDeliveries.EXPECT().DeliveredWordIDs(fewWords.ID, now.Add(-quizInterval)).
+		Return([]primitive.ObjectID{primitive.NewObjectID()}, nil)
+
+	// When
+	sent, err := suite.service.SendWeeklyQuizzes(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Zero(sent)
+	suite.mockMailSender.AssertNotCalled(suite.T(), "SendQuizEmail", mock.Anything, mock.Anything)
+	suite.mockRepo.AssertNotCalled(suite.T(), "FindLatest", paused.ID)
+}
+
+func (suite *QuizServiceTestSuite) TestSendWeeklyQuizzes_FailedSendIsForgotten() {
+	// Given
+	now := time.Date(2025, 8, 10, 9, 0, 0, 0, time.UTC)
+	sub := &subscription.Subscription{ID: primitive.NewObjectID(), Email: "user@example.com"}
+	words := quizWords()
+
+	suite.mockSubscriptions.EXPECT().FindDeliverableSubscriptions().Return([]*subscription.Subscription{sub}, nil)
+	suite.mockRepo.EXPECT().FindLatest(sub.ID).Return(nil, ErrQuizNotFound)
+	suite.mockDeliveries.EXPECT().DeliveredWordIDs(sub.ID, now.Add(-quizInterval)).
+		Return([]primitive.ObjectID{words[0].ID, words[1].ID, words[2].ID}, nil)
+	for _, w := range words {
+		suite.mockWords.EXPECT().FindWord(w.ID.Hex()).Return(w, nil)
+	}
+	suite.mockWords.EXPECT().SampleWords(distractorPoolSize, mock.Anything).Return(nil, nil)
+	var saved *Quiz
+	suite.mockRepo.EXPECT().SaveQuiz(mock.Anything).RunAndReturn(func(quiz *Quiz) (*Quiz, error) {
+		saved = quiz
+		return quiz, nil
+	})
+	suite.mockMailSender.EXPECT().SendQuizEmail(mock.Anything, mock.Anything).Return(errors.New("smtp unavailable"))
+	suite.mockRepo.EXPECT().DeleteQuiz(mock.Anything).Return(nil)
+
+	// When
+	sent, err := suite.service.SendWeeklyQuizzes(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Zero(sent)
+	suite.Require().NotNil(saved, "Expected the quiz to be stored before it is mailed")
+	suite.mockRepo.AssertCalled(suite.T(), "DeleteQuiz", saved.ID)
+}
+
+func (suite *QuizServiceTestSuite) TestRecordAnswer_Success() {
+	// Given
+	quiz := &Quiz{
+		ID:             primitive.NewObjectID(),
+		SubscriptionID: primitive.NewObjectID(),
+		Questions: []*Question{
+			{Prompt: "serendipity", Choices: []string{"덧없는", "뜻밖의 행운", "회복력"}, AnswerIndex: 1},
+			{Prompt: "ephemeral", Choices: []string{"덧없는", "뜻밖의 행운", "회복력"}, AnswerIndex: 0},
+		},
+	}
+	token, err := suite.provider.GenerateQuizToken(quiz.SubscriptionID.Hex(), quiz.ID.Hex(), 0, 1)
+	suite.Require().NoError(err)
+
+	suite.mockRepo.EXPECT().FindById(quiz.ID.Hex()).Return(quiz, nil)
+	suite.mockRepo.EXPECT().AnswerQuestion(quiz.ID, 0, 1, mock.Anything).Return(true, nil)
+
+	// When
+	result, err := suite.service.RecordAnswer(token, time.Now())
+
+	// Then
+	suite.NoError(err)
+	suite.True(result.Question.Correct())
+	suite.Equal(Score{Total: 2, Answered: 1, Correct: 1}, result.Score)
+}
+
+func (suite *QuizServiceTestSuite) TestRecordAnswer_AlreadyAnswered() {
+	// Given
+	answeredAt := time.Now().Add(-time.Hour)
+	quiz := &Quiz{
+		ID:             primitive.NewObjectID(),
+		SubscriptionID: primitive.NewObjectID(),
+		Questions: []*Question{
+			{Choices: []string{"덧없는", "뜻밖의 행운", "회복력"}, AnswerIndex: 1, ChosenIndex: 2, AnsweredAt: answeredAt},
+		},
+	}
+	token, err := suite.provider.GenerateQuizToken(quiz.SubscriptionID.Hex(), quiz.ID.Hex(), 0, 1)
+	suite.Require().NoError(err)
+	suite.mockRepo.EXPECT().FindById(quiz.ID.Hex()).Return(quiz, nil)
+
+	// When
+	result, err := suite.service.RecordAnswer(token, time.Now())
+
+	// Then
+	suite.NoError(err)
+	suite.False(result.Question.Correct(), "The first answer should be kept")
+	suite.mockRepo.AssertNotCalled(suite.T(), "AnswerQuestion", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *QuizServiceTestSuite) TestRecordAnswer_AnsweredAtTheSameTime() {
+	// Given
+	quiz := &Quiz{
+		ID:             primitive.NewObjectID(),
+		SubscriptionID: primitive.NewObjectID(),
+		Questions:      []*Question{{Choices: []string{"덧없는", "뜻밖의 행운", "회복력"}, AnswerIndex: 1}},
+	}
+	stored := &Quiz{
+		ID:             quiz.ID,
+		SubscriptionID: quiz.SubscriptionID,
+		Questions:      []*Question{{Choices: []string{"덧없는", "뜻밖의 행운", "회복력"}, AnswerIndex: 1, ChosenIndex: 2, AnsweredAt: time.Now()}},
+	}
+	token, err := suite.provider.GenerateQuizToken(quiz.SubscriptionID.Hex(), quiz.ID.Hex(), 0, 1)
+	suite.Require().NoError(err)
+	suite.mockRepo.EXPECT().FindById(quiz.ID.Hex()).Return(quiz, nil).Once()
+	suite.mockRepo.EXPECT().AnswerQuestion(quiz.ID, 0, 1, mock.Anything).Return(false, nil)
+	suite.mockRepo.EXPECT().FindById(quiz.ID.Hex()).Return(stored, nil).Once()
+
+	// When
+	result, err := suite.service.RecordAnswer(token, time.Now())
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(2, result.Question.ChosenIndex, "Expected the answer stored first to count")
+	suite.Equal(Score{Total: 1, Answered: 1, Correct: 0}, result.Score)
+}
+
+func (suite *QuizServiceTestSuite) TestRecordAnswer_Mismatch() {
+	// Given
+	quiz := &Quiz{ID: primitive.NewObjectID(), SubscriptionID: primitive.NewObjectID()}
+	token, err := suite.provider.GenerateQuizToken(primitive.NewObjectID().Hex(), quiz.ID.Hex(), 0, 0)
+	suite.Require().NoError(err)
+	suite.mockRepo.EXPECT().FindById(quiz.ID.Hex()).Return(quiz, nil)
+
+	// When
+	_, err = suite.service.RecordAnswer(token, time.Now())
+
+	// Then
+	suite.ErrorIs(err, ErrQuizMismatch)
+}
@@ -0,0 +1,8 @@
+package review
+
+import "errors"
+
+var (
+	ErrReviewNotFound = errors.New("review not found")
+	ErrReviewMismatch = errors.New("review does not belong to the subscription")
+)
@@ -0,0 +1,367 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package review
+
+import (
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	mock "github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindById provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindById(id string) (*Review, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *Review
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*Review, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *Review); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Review)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - id string
+func (_e *MockRepository_Expecter) FindById(id interface{}) *MockRepository_FindById_Call {
+	return &MockRepository_FindById_Call{Call: _e.mock.On("FindById", id)}
+}
+
+func (_c *MockRepository_FindById_Call) Run(run func(id string)) *MockRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindById_Call) Return(review *Review, err error) *MockRepository_FindById_Call {
+	_c.Call.Return(review, err)
+	return _c
+}
+
+func (_c *MockRepository_FindById_Call) RunAndReturn(run func(id string) (*Review, error)) *MockRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDue provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindDue(subscriptionID primitive.ObjectID, now time.Time, limit int) ([]*Review, error) {
+	ret := _mock.Called(subscriptionID, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDue")
+	}
+
+	var r0 []*Review
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, time.Time, int) ([]*Review, error)); ok {
+		return returnFunc(subscriptionID, now, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, time.Time, int) []*Review); ok {
+		r0 = returnFunc(subscriptionID, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Review)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID, time.Time, int) error); ok {
+		r1 = returnFunc(subscriptionID, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDue'
+type MockRepository_FindDue_Call struct {
+	*mock.Call
+}
+
+// FindDue is a helper method to define mock.On call
+//   - subscriptionID primitive.ObjectID
+//   - now time.Time
+//   - limit int
+func (_e *MockRepository_Expecter) FindDue(subscriptionID interface{}, now interface{}, limit interface{}) *MockRepository_FindDue_Call {
+	return &MockRepository_FindDue_Call{Call: _e.mock.On("FindDue", subscriptionID, now, limit)}
+}
+
+func (_c *MockRepository_FindDue_Call) Run(run func(subscriptionID primitive.ObjectID, now time.Time, limit int)) *MockRepository_FindDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindDue_Call) Return(reviews []*Review, err error) *MockRepository_FindDue_Call {
+	_c.Call.Return(reviews, err)
+	return _c
+}
+
+func (_c *MockRepository_FindDue_Call) RunAndReturn(run func(subscriptionID primitive.ObjectID, now time.Time, limit int) ([]*Review, error)) *MockRepository_FindDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveIfAbsent provides a mock function for the type MockRepository
+func (_mock *MockRepository) SaveIfAbsent(review *Review) error {
+	ret := _mock.Called(review)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIfAbsent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*Review) error); ok {
+		r0 = returnFunc(review)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SaveIfAbsent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIfAbsent'
+type MockRepository_SaveIfAbsent_Call struct {
+	*mock.Call
+}
+
+// SaveIfAbsent is a helper method to define mock.On call
+//   - review *Review
+func (_e *MockRepository_Expecter) SaveIfAbsent(review interface{}) *MockRepository_SaveIfAbsent_Call {
+	return &MockRepository_SaveIfAbsent_Call{Call: _e.mock.On("SaveIfAbsent", review)}
+}
+
+func (_c *MockRepository_SaveIfAbsent_Call) Run(run func(review *Review)) *MockRepository_SaveIfAbsent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Review
+		if args[0] != nil {
+			arg0 = args[0].(*Review)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveIfAbsent_Call) Return(err error) *MockRepository_SaveIfAbsent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SaveIfAbsent_Call) RunAndReturn(run func(review *Review) error) *MockRepository_SaveIfAbsent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateReview provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateReview(review *Review) error {
+	ret := _mock.Called(review)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReview")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*Review) error); ok {
+		r0 = returnFunc(review)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateReview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateReview'
+type MockRepository_UpdateReview_Call struct {
+	*mock.Call
+}
+
+// UpdateReview is a helper method to define mock.On call
+//   - review *Review
+func (_e *MockRepository_Expecter) UpdateReview(review interface{}) *MockRepository_UpdateReview_Call {
+	return &MockRepository_UpdateReview_Call{Call: _e.mock.On("UpdateReview", review)}
+}
+
+func (_c *MockRepository_UpdateReview_Call) Run(run func(review *Review)) *MockRepository_UpdateReview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Review
+		if args[0] != nil {
+			arg0 = args[0].(*Review)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateReview_Call) Return(err error) *MockRepository_UpdateReview_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateReview_Call) RunAndReturn(run func(review *Review) error) *MockRepository_UpdateReview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWords creates a new instance of MockWords. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWords(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWords {
+	mock := &MockWords{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWords is an autogenerated mock type for the Words type
+type MockWords struct {
+	mock.Mock
+}
+
+type MockWords_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWords) EXPECT() *MockWords_Expecter {
+	return &MockWords_Expecter{mock: &_m.Mock}
+}
+
+// FindWord provides a mock function for the type MockWords
+func (_mock *MockWords) FindWord(id string) (*word.Word, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWord")
+	}
+
+	var r0 *word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*word.Word, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *word.Word); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_FindWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWord'
+type MockWords_FindWord_Call struct {
+	*mock.Call
+}
+
+// FindWord is a helper method to define mock.On call
+//   - id string
+func (_e *MockWords_Expecter) FindWord(id interface{}) *MockWords_FindWord_Call {
+	return &MockWords_FindWord_Call{Call: _e.mock.On("FindWord", id)}
+}
+
+func (_c *MockWords_FindWord_Call) Run(run func(id string)) *MockWords_FindWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) Return(word1 *word.Word, err error) *MockWords_FindWord_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) RunAndReturn(run func(id string) (*word.Word, error)) *MockWords_FindWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
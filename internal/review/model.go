@@ -0,0 +1,64 @@
+package review
+
+import (
+	"math"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	initialEaseFactor = 2.5
+	minEaseFactor     = 1.3
+	// qualityRemembered and qualityForgot are the SM-2 grades (0-5) behind the two answer links.
+	qualityRemembered = 4
+	qualityForgot     = 1
+)
+
+// Review is the SM-2 repetition state of one word for one subscriber.
+type Review struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	SubscriptionID primitive.ObjectID `bson:"subscription_id"`
+	WordID         primitive.ObjectID `bson:"word_id"`
+	Repetitions    int                `bson:"repetitions"`
+	IntervalDays   int                `bson:"interval_days"`
+	EaseFactor     float64            `bson:"ease_factor"`
+	DueAt          time.Time          `bson:"due_at"`
+	LastReviewedAt time.Time          `bson:"last_reviewed_at"`
+	CreatedAt      time.Time          `bson:"created_at"`
+}
+
+// NewReview schedules the first review of a word one day after it was delivered.
+func NewReview(subscriptionID, wordID primitive.ObjectID, deliveredAt time.Time) *Review {
+	return &Review{
+		SubscriptionID: subscriptionID,
+		WordID:         wordID,
+		IntervalDays:   1,
+		EaseFactor:     initialEaseFactor,
+		DueAt:          deliveredAt.AddDate(0, 0, 1),
+		CreatedAt:      deliveredAt,
+	}
+}
+
+// answer applies the SM-2 algorithm for a response of the given quality (0-5) at now.
+func (r *Review) answer(quality int, now time.Time) {
+	if quality >= 3 {
+		switch r.Repetitions {
+		case 0:
+			r.IntervalDays = 1
+		case 1:
+			r.IntervalDays = 6
+		default:
+			r.IntervalDays = int(math.Round(float64(r.IntervalDays) * r.EaseFactor))
+		}
+		r.Repetitions++
+	} else {
+		r.Repetitions = 0
+		r.IntervalDays = 1
+	}
+
+	penalty := float64(5 - quality)
+	r.EaseFactor = math.Max(minEaseFactor, r.EaseFactor+0.1-penalty*(0.08+penalty*0.02))
+	r.LastReviewedAt = now
+	r.DueAt = now.AddDate(0, 0, r.IntervalDays)
+}
@@ -0,0 +1,50 @@
+package review
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestReview_Answer(t *testing.T) {
+	now := time.Date(2025, 8, 1, 8, 0, 0, 0, time.UTC)
+
+	t.Run("Remembered Intervals Grow", func(t *testing.T) {
+		review := NewReview(primitive.NewObjectID(), primitive.NewObjectID(), now)
+
+		var intervals []int
+		for i := 0; i < 4; i++ {
+			review.answer(qualityRemembered, now)
+			intervals = append(intervals, review.IntervalDays)
+		}
+
+		assert.Equal(t, []int{1, 6, 15, 38}, intervals)
+		assert.Equal(t, 4, review.Repetitions)
+		assert.InDelta(t, 2.5, review.EaseFactor, 0.0001)
+		assert.Equal(t, now.AddDate(0, 0, 38), review.DueAt)
+	})
+
+	t.Run("Forgot Resets Repetitions", func(t *testing.T) {
+		review := NewReview(primitive.NewObjectID(), primitive.NewObjectID(), now)
+		review.answer(qualityRemembered, now)
+		review.answer(qualityRemembered, now)
+
+		review.answer(qualityForgot, now)
+
+		assert.Equal(t, 0, review.Repetitions)
+		assert.Equal(t, 1, review.IntervalDays)
+		assert.InDelta(t, 1.96, review.EaseFactor, 0.0001)
+		assert.Equal(t, now.AddDate(0, 0, 1), review.DueAt)
+	})
+
+	t.Run("Ease Factor Floor", func(t *testing.T) {
+		review := NewReview(primitive.NewObjectID(), primitive.NewObjectID(), now)
+		for i := 0; i < 10; i++ {
+			review.answer(qualityForgot, now)
+		}
+
+		assert.Equal(t, minEaseFactor, review.EaseFactor)
+	})
+}
@@ -0,0 +1,84 @@
+package review
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const collectionName = "reviews"
+
+type MongoRepository struct {
+	collection *mongo.Collection
+}
+
+func NewReviewRepo(db *mongo.Database) *MongoRepository {
+	return &MongoRepository{
+		collection: db.Collection(collectionName),
+	}
+}
+
+// SaveIfAbsent stores review unless the subscription already has a review of the same word.
+func (r *MongoRepository) SaveIfAbsent(review *Review) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"subscription_id": review.SubscriptionID, "word_id": review.WordID}
+	update := bson.M{"$setOnInsert": review}
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *MongoRepository) FindById(id string) (*Review, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	result := r.collection.FindOne(ctx, bson.M{"_id": objectID})
+	if errors.Is(result.Err(), mongo.ErrNoDocuments) {
+		return nil, ErrReviewNotFound
+	}
+
+	review := &Review{}
+	if err := result.Decode(review); err != nil {
+		return nil, fmt.Errorf("failed to decode review: %w", err)
+	}
+	return review, nil
+}
+
+// FindDue returns up to limit reviews of the subscription due at now, most overdue first.
+func (r *MongoRepository) FindDue(subscriptionID primitive.ObjectID, now time.Time, limit int) ([]*Review, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"subscription_id": subscriptionID, "due_at": bson.M{"$lte": now}}
+	findOptions := options.Find().SetSort(bson.D{{Key: "due_at", Value: 1}}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviews []*Review
+	if err := cursor.All(ctx, &reviews); err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+func (r *MongoRepository) UpdateReview(review *Review) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": review.ID}, bson.M{"$set": review})
+	return err
+}
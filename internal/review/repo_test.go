@@ -0,0 +1,56 @@
+package review
+
+import (
+	"log"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/infra/testhelper"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReviewRepoTestSuite struct {
+	suite.Suite
+	database *testhelper.TestDatabase
+	repo     *MongoRepository
+}
+
+func (suite *ReviewRepoTestSuite) SetupSuite() {
+	log.Println("Setting up ReviewRepoTestSuite...")
+	suite.database = testhelper.SetupTestDatabase()
+	suite.repo = NewReviewRepo(suite.database.DbInstance)
+}
+
+func (suite *ReviewRepoTestSuite) TearDownSuite() {
+	log.Println("Tearing down ReviewRepoTestSuite...")
+	suite.database.TearDown()
+}
+
+func (suite *ReviewRepoTestSuite) BeforeTest(suiteName, testName string) {
+	log.Printf("Before test: %s - %s\n", suiteName, testName)
+	if err := suite.database.CleanUp(); err != nil {
+		log.Fatalf("Failed to clean up database before test: %v", err)
+	}
+}
+
+func TestReviewRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(ReviewRepoTestSuite))
+}
+
+func (suite *ReviewRepoTestSuite) TestReviewRepository_SaveIfAbsentAndFindDue() {
+	suite.Run("One review per word, due ones only", func() {
+		now := time.Now()
+		subscriptionID := primitive.NewObjectID()
+		dueWord, laterWord := primitive.NewObjectID(), primitive.NewObjectID()
+
+		suite.NoError(suite.repo.SaveIfAbsent(NewReview(subscriptionID, dueWord, now.AddDate(0, 0, -2))))
+		suite.NoError(suite.repo.SaveIfAbsent(NewReview(subscriptionID, dueWord, now)), "Expected a second schedule of the same word to be ignored")
+		suite.NoError(suite.repo.SaveIfAbsent(NewReview(subscriptionID, laterWord, now)))
+
+		due, err := suite.repo.FindDue(subscriptionID, now, maxReviewsPerEmail)
+		suite.NoError(err, "Expected no error when finding due reviews")
+		suite.Require().Len(due, 1)
+		suite.Equal(dueWord, due[0].WordID)
+	})
+}
@@ -0,0 +1,130 @@
+package review
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/auth"
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// maxReviewsPerEmail caps how many due reviews are mixed into one daily email.
+const maxReviewsPerEmail = 3
+
+type Repository interface {
+	SaveIfAbsent(review *Review) error
+	FindById(id string) (*Review, error)
+	FindDue(subscriptionID primitive.ObjectID, now time.Time, limit int) ([]*Review, error)
+	UpdateReview(review *Review) error
+}
+
+type Words interface {
+	FindWord(id string) (*word.Word, error)
+}
+
+type Service struct {
+	repository  Repository
+	words       Words
+	jwtProvider *auth.JwtProvider
+}
+
+func NewReviewService(repo Repository, words Words, provider *auth.JwtProvider) *Service {
+	return &Service{
+		repository:  repo,
+		words:       words,
+		jwtProvider: provider,
+	}
+}
+
+// DueReview is a review ready to be mailed with its signed answer links.
+type DueReview struct {
+	Review         *Review
+	Word           *word.Word
+	RememberedLink string
+	ForgotLink     string
+}
+
+// Schedule starts spaced repetition of a word the subscription has just received.
+func (s *Service) Schedule(subscriptionID, wordID primitive.ObjectID, deliveredAt time.Time) error {
+	return s.repository.SaveIfAbsent(NewReview(subscriptionID, wordID, deliveredAt))
+}
+
+// DueReviews returns the reviews to mix into the subscription's email at now.
+// Reviews stay due until the subscriber answers them.
+func (s *Service) DueReviews(subscriptionID primitive.ObjectID, now time.Time) ([]*DueReview, error) {
+	reviews, err := s.repository.FindDue(subscriptionID, now, maxReviewsPerEmail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due reviews: %w", err)
+	}
+
+	dueReviews := make([]*DueReview, 0, len(reviews))
+	for _, review := range reviews {
+		reviewWord, err := s.words.FindWord(review.WordID.Hex())
+		if err != nil {
+			log.Printf("Skipping review %s of missing word %s: %v", review.ID.Hex(), review.WordID.Hex(), err)
+			continue
+		}
+		if reviewWord.IsDeleted() {
+			continue
+		}
+
+		rememberedLink, err := s.answerURL(review, true)
+		if err != nil {
+			return nil, err
+		}
+		forgotLink, err := s.answerURL(review, false)
+		if err != nil {
+			return nil, err
+		}
+
+		dueReviews = append(dueReviews, &DueReview{
+			Review:         review,
+			Word:           reviewWord,
+			RememberedLink: rememberedLink,
+			ForgotLink:     forgotLink,
+		})
+	}
+	return dueReviews, nil
+}
+
+// RecordAnswer feeds a "remembered" or "forgot" link back into the review schedule.
+func (s *Service) RecordAnswer(token string, now time.Time) (*Review, error) {
+	claims, err := s.jwtProvider.ParseReviewToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse review token: %w", err)
+	}
+
+	review, err := s.repository.FindById(claims.ReviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.SubscriptionID.Hex() != claims.SubscriptionID {
+		return nil, ErrReviewMismatch
+	}
+	if now.Before(review.DueAt) {
+		// Already answered, e.g. the link was clicked twice; keep the schedule as it is.
+		return review, nil
+	}
+
+	quality := qualityForgot
+	if claims.Remembered {
+		quality = qualityRemembered
+	}
+	review.answer(quality, now)
+
+	if err := s.repository.UpdateReview(review); err != nil {
+		return nil, fmt.Errorf("failed to update review: %w", err)
+	}
+	return review, nil
+}
+
+func (s *Service) answerURL(review *Review, remembered bool) (string, error) {
+	token, err := s.jwtProvider.GenerateReviewToken(review.SubscriptionID.Hex(), review.ID.Hex(), remembered)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate review token: %w", err)
+	}
+	return fmt.Sprintf("%s/reviews/answer/%s", os.Getenv("APP_BASE_URL"), token), nil
+}
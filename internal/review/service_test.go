@@ -0,0 +1,135 @@
+package review
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/auth"
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ReviewServiceTestSuite struct {
+	suite.Suite
+	mockRepo  *MockRepository
+	mockWords *MockWords
+	provider  *auth.JwtProvider
+	service   *Service
+}
+
+func (suite *ReviewServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockRepository)
+	suite.mockWords = new(MockWords)
+	provider, _ := auth.NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	suite.provider = provider
+	suite.service = NewReviewService(suite.mockRepo, suite.mockWords, provider)
+}
+
+func TestReviewServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ReviewServiceTestSuite))
+}
+
+func (suite *ReviewServiceTestSuite) TestSchedule_FirstReviewIsDueNextDay() {
+	// Given
+	subscriptionID, wordID := primitive.NewObjectID(), primitive.NewObjectID()
+	deliveredAt := time.Date(2025, 8, 1, 8, 0, 0, 0, time.UTC)
+	suite.mockRepo.EXPECT().SaveIfAbsent(mock.MatchedBy(func(review *Review) bool {
+		return review.SubscriptionID == subscriptionID && review.WordID == wordID && review.DueAt.Equal(deliveredAt.AddDate(0, 0, 1))
+	})).Return(nil)
+
+	// When
+	err := suite.service.Schedule(subscriptionID, wordID, deliveredAt)
+
+	// Then
+	suite.NoError(err)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ReviewServiceTestSuite) TestDueReviews_SignsAnswerLinks() {
+	// Given
+	now := time.Now()
+	review := &Review{ID: primitive.NewObjectID(), SubscriptionID: primitive.NewObjectID(), WordID: primitive.NewObjectID()}
+	missing := &Review{ID: primitive.NewObjectID(), SubscriptionID: review.SubscriptionID, WordID: primitive.NewObjectID()}
+	suite.mockRepo.EXPECT().FindDue(review.SubscriptionID, now, maxReviewsPerEmail).Return([]*Review{review, missing}, nil)
+	suite.mockWords.EXPECT().FindWord(review.WordID.Hex()).Return(&word.Word{Text: "serendipity"}, nil)
+	suite.mockWords.EXPECT().FindWord(missing.WordID.Hex()).Return(nil, errors.New("not found"))
+
+	// When
+	dueReviews, err := suite.service.DueReviews(review.SubscriptionID, now)
+
+	// Then
+	suite.NoError(err)
+	suite.Require().Len(dueReviews, 1)
+	suite.Equal("serendipity", dueReviews[0].Word.Text)
+	suite.Contains(dueReviews[0].RememberedLink, "/reviews/answer/")
+	suite.NotEqual(dueReviews[0].RememberedLink, dueReviews[0].ForgotLink)
+}
+
+func (suite *ReviewServiceTestSuite) TestDueReviews_SkipsDeletedWords() {
+	// Given
+	now := time.Now()
+	review := &Review{ID: primitive.NewObjectID(), SubscriptionID: primitive.NewObjectID(), WordID: primitive.NewObjectID()}
+	suite.mockRepo.EXPECT().FindDue(review.SubscriptionID, now, maxReviewsPerEmail).Return([]*Review{review}, nil)
+	suite.mockWords.EXPECT().FindWord(review.WordID.Hex()).Return(&word.Word{Text: "serendipity", DeletedAt: now}, nil)
+
+	// When
+	dueReviews, err := suite.service.DueReviews(review.SubscriptionID, now)
+
+	// Then
+	suite.NoError(err)
+	suite.Empty(dueReviews)
+}
+
+func (suite *ReviewServiceTestSuite) TestRecordAnswer_Remembered() {
+	// Given
+	now := time.Now()
+	review := NewReview(primitive.NewObjectID(), primitive.NewObjectID(), now.AddDate(0, 0, -1))
+	review.ID = primitive.NewObjectID()
+	token, err := suite.provider.GenerateReviewToken(review.SubscriptionID.Hex(), review.ID.Hex(), true)
+	suite.Require().NoError(err)
+
+	suite.mockRepo.EXPECT().FindById(review.ID.Hex()).Return(review, nil)
+	suite.mockRepo.EXPECT().UpdateReview(review).Return(nil)
+
+	// When
+	answered, err := suite.service.RecordAnswer(token, now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(1, answered.Repetitions)
+	suite.Equal(now.AddDate(0, 0, 1), answered.DueAt)
+}
+
+func (suite *ReviewServiceTestSuite) TestRecordAnswer_AlreadyAnswered() {
+	// Given
+	now := time.Now()
+	review := NewReview(primitive.NewObjectID(), primitive.NewObjectID(), now)
+	review.ID = primitive.NewObjectID()
+	token, err := suite.provider.GenerateReviewToken(review.SubscriptionID.Hex(), review.ID.Hex(), false)
+	suite.Require().NoError(err)
+	suite.mockRepo.EXPECT().FindById(review.ID.Hex()).Return(review, nil)
+
+	// When
+	_, err = suite.service.RecordAnswer(token, now)
+
+	// Then
+	suite.NoError(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateReview", mock.Anything)
+}
+
+func (suite *ReviewServiceTestSuite) TestRecordAnswer_OtherSubscription() {
+	// Given
+	review := &Review{ID: primitive.NewObjectID(), SubscriptionID: primitive.NewObjectID()}
+	token, err := suite.provider.GenerateReviewToken(primitive.NewObjectID().Hex(), review.ID.Hex(), true)
+	suite.Require().NoError(err)
+	suite.mockRepo.EXPECT().FindById(review.ID.Hex()).Return(review, nil)
+
+	// When
+	_, err = suite.service.RecordAnswer(token, time.Now())
+
+	// Then
+	suite.ErrorIs(err, ErrReviewMismatch)
+}
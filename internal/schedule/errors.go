@@ -0,0 +1,15 @@
+package schedule
+
+import "errors"
+
+var (
+	ErrEntryNotFound    = errors.New("no word scheduled on that date")
+	ErrInvalidDate      = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrDateInPast       = errors.New("date is in the past")
+	ErrWordNotFound     = errors.New("word not found")
+	ErrWordDeleted      = errors.New("word has been deleted")
+	ErrWordDelivered    = errors.New("word has already been delivered")
+	ErrWordNotApproved  = errors.New("word has not been approved")
+	ErrAlreadyScheduled = errors.New("word is already scheduled on another date")
+	ErrDuplicateWord    = errors.New("word appears more than once in the order")
+)
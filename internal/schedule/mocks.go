@@ -0,0 +1,535 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package schedule
+
+import (
+	"github.com/Go-roro/wordrop/internal/word"
+	mock "github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// DeleteByDate provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteByDate(date string) error {
+	ret := _mock.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByDate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(date)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByDate'
+type MockRepository_DeleteByDate_Call struct {
+	*mock.Call
+}
+
+// DeleteByDate is a helper method to define mock.On call
+//   - date string
+func (_e *MockRepository_Expecter) DeleteByDate(date interface{}) *MockRepository_DeleteByDate_Call {
+	return &MockRepository_DeleteByDate_Call{Call: _e.mock.On("DeleteByDate", date)}
+}
+
+func (_c *MockRepository_DeleteByDate_Call) Run(run func(date string)) *MockRepository_DeleteByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteByDate_Call) Return(err error) *MockRepository_DeleteByDate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteByDate_Call) RunAndReturn(run func(date string) error) *MockRepository_DeleteByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByWordIDFrom provides a mock function for the type MockRepository
+func (_mock *MockRepository) DeleteByWordIDFrom(wordID primitive.ObjectID, from string) error {
+	ret := _mock.Called(wordID, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByWordIDFrom")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, string) error); ok {
+		r0 = returnFunc(wordID, from)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_DeleteByWordIDFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByWordIDFrom'
+type MockRepository_DeleteByWordIDFrom_Call struct {
+	*mock.Call
+}
+
+// DeleteByWordIDFrom is a helper method to define mock.On call
+//   - wordID primitive.ObjectID
+//   - from string
+func (_e *MockRepository_Expecter) DeleteByWordIDFrom(wordID interface{}, from interface{}) *MockRepository_DeleteByWordIDFrom_Call {
+	return &MockRepository_DeleteByWordIDFrom_Call{Call: _e.mock.On("DeleteByWordIDFrom", wordID, from)}
+}
+
+func (_c *MockRepository_DeleteByWordIDFrom_Call) Run(run func(wordID primitive.ObjectID, from string)) *MockRepository_DeleteByWordIDFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_DeleteByWordIDFrom_Call) Return(err error) *MockRepository_DeleteByWordIDFrom_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_DeleteByWordIDFrom_Call) RunAndReturn(run func(wordID primitive.ObjectID, from string) error) *MockRepository_DeleteByWordIDFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByDate provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByDate(date string) (*Entry, error) {
+	ret := _mock.Called(date)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByDate")
+	}
+
+	var r0 *Entry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*Entry, error)); ok {
+		return returnFunc(date)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *Entry); ok {
+		r0 = returnFunc(date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Entry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(date)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByDate'
+type MockRepository_FindByDate_Call struct {
+	*mock.Call
+}
+
+// FindByDate is a helper method to define mock.On call
+//   - date string
+func (_e *MockRepository_Expecter) FindByDate(date interface{}) *MockRepository_FindByDate_Call {
+	return &MockRepository_FindByDate_Call{Call: _e.mock.On("FindByDate", date)}
+}
+
+func (_c *MockRepository_FindByDate_Call) Run(run func(date string)) *MockRepository_FindByDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByDate_Call) Return(entry *Entry, err error) *MockRepository_FindByDate_Call {
+	_c.Call.Return(entry, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByDate_Call) RunAndReturn(run func(date string) (*Entry, error)) *MockRepository_FindByDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFrom provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindFrom(from string) ([]*Entry, error) {
+	ret := _mock.Called(from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFrom")
+	}
+
+	var r0 []*Entry
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]*Entry, error)); ok {
+		return returnFunc(from)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []*Entry); ok {
+		r0 = returnFunc(from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Entry)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(from)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindFrom_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFrom'
+type MockRepository_FindFrom_Call struct {
+	*mock.Call
+}
+
+// FindFrom is a helper method to define mock.On call
+//   - from string
+func (_e *MockRepository_Expecter) FindFrom(from interface{}) *MockRepository_FindFrom_Call {
+	return &MockRepository_FindFrom_Call{Call: _e.mock.On("FindFrom", from)}
+}
+
+func (_c *MockRepository_FindFrom_Call) Run(run func(from string)) *MockRepository_FindFrom_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindFrom_Call) Return(entrys []*Entry, err error) *MockRepository_FindFrom_Call {
+	_c.Call.Return(entrys, err)
+	return _c
+}
+
+func (_c *MockRepository_FindFrom_Call) RunAndReturn(run func(from string) ([]*Entry, error)) *MockRepository_FindFrom_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEntry provides a mock function for the type MockRepository
+func (_mock *MockRepository) SetEntry(entry *Entry) error {
+	ret := _mock.Called(entry)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEntry")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*Entry) error); ok {
+		r0 = returnFunc(entry)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_SetEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEntry'
+type MockRepository_SetEntry_Call struct {
+	*mock.Call
+}
+
+// SetEntry is a helper method to define mock.On call
+//   - entry *Entry
+func (_e *MockRepository_Expecter) SetEntry(entry interface{}) *MockRepository_SetEntry_Call {
+	return &MockRepository_SetEntry_Call{Call: _e.mock.On("SetEntry", entry)}
+}
+
+func (_c *MockRepository_SetEntry_Call) Run(run func(entry *Entry)) *MockRepository_SetEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Entry
+		if args[0] != nil {
+			arg0 = args[0].(*Entry)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SetEntry_Call) Return(err error) *MockRepository_SetEntry_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_SetEntry_Call) RunAndReturn(run func(entry *Entry) error) *MockRepository_SetEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWords creates a new instance of MockWords. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWords(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWords {
+	mock := &MockWords{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWords is an autogenerated mock type for the Words type
+type MockWords struct {
+	mock.Mock
+}
+
+type MockWords_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWords) EXPECT() *MockWords_Expecter {
+	return &MockWords_Expecter{mock: &_m.Mock}
+}
+
+// FindWord provides a mock function for the type MockWords
+func (_mock *MockWords) FindWord(id string) (*word.Word, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWord")
+	}
+
+	var r0 *word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*word.Word, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *word.Word); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_FindWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWord'
+type MockWords_FindWord_Call struct {
+	*mock.Call
+}
+
+// FindWord is a helper method to define mock.On call
+//   - id string
+func (_e *MockWords_Expecter) FindWord(id interface{}) *MockWords_FindWord_Call {
+	return &MockWords_FindWord_Call{Call: _e.mock.On("FindWord", id)}
+}
+
+func (_c *MockWords_FindWord_Call) Run(run func(id string)) *MockWords_FindWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) Return(word1 *word.Word, err error) *MockWords_FindWord_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockWords_FindWord_Call) RunAndReturn(run func(id string) (*word.Word, error)) *MockWords_FindWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetScheduled provides a mock function for the type MockWords
+func (_mock *MockWords) SetScheduled(wordID primitive.ObjectID, scheduled bool) error {
+	ret := _mock.Called(wordID, scheduled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetScheduled")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, bool) error); ok {
+		r0 = returnFunc(wordID, scheduled)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWords_SetScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetScheduled'
+type MockWords_SetScheduled_Call struct {
+	*mock.Call
+}
+
+// SetScheduled is a helper method to define mock.On call
+//   - wordID primitive.ObjectID
+//   - scheduled bool
+func (_e *MockWords_Expecter) SetScheduled(wordID interface{}, scheduled interface{}) *MockWords_SetScheduled_Call {
+	return &MockWords_SetScheduled_Call{Call: _e.mock.On("SetScheduled", wordID, scheduled)}
+}
+
+func (_c *MockWords_SetScheduled_Call) Run(run func(wordID primitive.ObjectID, scheduled bool)) *MockWords_SetScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_SetScheduled_Call) Return(err error) *MockWords_SetScheduled_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWords_SetScheduled_Call) RunAndReturn(run func(wordID primitive.ObjectID, scheduled bool) error) *MockWords_SetScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UndeliveredWords provides a mock function for the type MockWords
+func (_mock *MockWords) UndeliveredWords(excludeIDs []primitive.ObjectID, limit int) ([]*word.Word, error) {
+	ret := _mock.Called(excludeIDs, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UndeliveredWords")
+	}
+
+	var r0 []*word.Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]primitive.ObjectID, int) ([]*word.Word, error)); ok {
+		return returnFunc(excludeIDs, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]primitive.ObjectID, int) []*word.Word); ok {
+		r0 = returnFunc(excludeIDs, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*word.Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]primitive.ObjectID, int) error); ok {
+		r1 = returnFunc(excludeIDs, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWords_UndeliveredWords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndeliveredWords'
+type MockWords_UndeliveredWords_Call struct {
+	*mock.Call
+}
+
+// UndeliveredWords is a helper method to define mock.On call
+//   - excludeIDs []primitive.ObjectID
+//   - limit int
+func (_e *MockWords_Expecter) UndeliveredWords(excludeIDs interface{}, limit interface{}) *MockWords_UndeliveredWords_Call {
+	return &MockWords_UndeliveredWords_Call{Call: _e.mock.On("UndeliveredWords", excludeIDs, limit)}
+}
+
+func (_c *MockWords_UndeliveredWords_Call) Run(run func(excludeIDs []primitive.ObjectID, limit int)) *MockWords_UndeliveredWords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].([]primitive.ObjectID)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWords_UndeliveredWords_Call) Return(words []*word.Word, err error) *MockWords_UndeliveredWords_Call {
+	_c.Call.Return(words, err)
+	return _c
+}
+
+func (_c *MockWords_UndeliveredWords_Call) RunAndReturn(run func(excludeIDs []primitive.ObjectID, limit int) ([]*word.Word, error)) *MockWords_UndeliveredWords_Call {
+	_c.Call.Return(run)
+	return _c
+}
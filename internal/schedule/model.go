@@ -0,0 +1,33 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// dateLayout is how schedule dates are written, e.g. 2025-08-06.
+const dateLayout = "2006-01-02"
+
+// Entry assigns a word to the date it goes out on. There is at most one entry per date.
+type Entry struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Date      string             `bson:"date" json:"date"`
+	WordID    primitive.ObjectID `bson:"word_id" json:"word_id"`
+	UpdatedAt time.Time          `bson:"updated_at" json:"updated_at"`
+}
+
+// ScheduledWord is an entry together with its word.
+type ScheduledWord struct {
+	Date string     `json:"date"`
+	Word *word.Word `json:"word"`
+}
+
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, ErrInvalidDate
+	}
+	return date, nil
+}
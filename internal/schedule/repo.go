@@ -0,0 +1,110 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const collectionName = "schedule"
+
+type MongoRepository struct {
+	collection *mongo.Collection
+}
+
+func NewScheduleRepo(db *mongo.Database) *MongoRepository {
+	return &MongoRepository{
+		collection: db.Collection(collectionName),
+	}
+}
+
+// EnsureIndexes creates the unique index on the date of each entry, if it does not exist yet,
+// so two back-fills running at the same time cannot both schedule a word on the same date.
+func (r *MongoRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	index := mongo.IndexModel{
+		Keys:    bson.D{{Key: "date", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := r.collection.Indexes().CreateOne(ctx, index); err != nil {
+		log.Printf("Failed to create schedule indexes: %v", err)
+		return err
+	}
+	return nil
+}
+
+func (r *MongoRepository) FindByDate(date string) (*Entry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result := r.collection.FindOne(ctx, bson.M{"date": date})
+	if errors.Is(result.Err(), mongo.ErrNoDocuments) {
+		return nil, ErrEntryNotFound
+	}
+
+	entry := &Entry{}
+	if err := result.Decode(entry); err != nil {
+		return nil, fmt.Errorf("failed to decode schedule entry: %w", err)
+	}
+	return entry, nil
+}
+
+// FindFrom returns the entries on or after the date from, earliest first.
+func (r *MongoRepository) FindFrom(from string) ([]*Entry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "date", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"date": bson.M{"$gte": from}}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// SetEntry schedules entry.WordID on entry.Date, replacing whatever was scheduled on that date.
+func (r *MongoRepository) SetEntry(entry *Entry) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{"word_id": entry.WordID, "updated_at": entry.UpdatedAt}}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"date": entry.Date}, update, options.Update().SetUpsert(true))
+	return err
+}
+
+func (r *MongoRepository) DeleteByDate(date string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"date": date})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrEntryNotFound
+	}
+	return nil
+}
+
+// DeleteByWordIDFrom unschedules the word from any date on or after from.
+func (r *MongoRepository) DeleteByWordIDFrom(wordID primitive.ObjectID, from string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.collection.DeleteMany(ctx, bson.M{"word_id": wordID, "date": bson.M{"$gte": from}})
+	return err
+}
@@ -0,0 +1,80 @@
+package schedule
+
+import (
+	"context"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/infra/testhelper"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type ScheduleRepoTestSuite struct {
+	suite.Suite
+	database *testhelper.TestDatabase
+	repo     *MongoRepository
+}
+
+func (suite *ScheduleRepoTestSuite) SetupSuite() {
+	log.Println("Setting up ScheduleRepoTestSuite...")
+	suite.database = testhelper.SetupTestDatabase()
+	suite.repo = NewScheduleRepo(suite.database.DbInstance)
+}
+
+func (suite *ScheduleRepoTestSuite) TearDownSuite() {
+	log.Println("Tearing down ScheduleRepoTestSuite...")
+	suite.database.TearDown()
+}
+
+func (suite *ScheduleRepoTestSuite) BeforeTest(suiteName, testName string) {
+	log.Printf("Before test: %s - %s\n", suiteName, testName)
+	if err := suite.database.CleanUp(); err != nil {
+		log.Fatalf("Failed to clean up database before test: %v", err)
+	}
+}
+
+func TestScheduleRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleRepoTestSuite))
+}
+
+func (suite *ScheduleRepoTestSuite) TestScheduleRepository_SetEntryReplacesDate() {
+	suite.Run("One word per date", func() {
+		replaced, replacement := primitive.NewObjectID(), primitive.NewObjectID()
+		suite.NoError(suite.repo.SetEntry(&Entry{Date: "2025-08-07", WordID: replaced, UpdatedAt: time.Now()}))
+		suite.NoError(suite.repo.SetEntry(&Entry{Date: "2025-08-07", WordID: replacement, UpdatedAt: time.Now()}))
+		suite.NoError(suite.repo.SetEntry(&Entry{Date: "2025-08-05", WordID: primitive.NewObjectID(), UpdatedAt: time.Now()}))
+
+		entries, err := suite.repo.FindFrom("2025-08-06")
+		suite.NoError(err, "Expected no error when finding the schedule")
+		suite.Require().Len(entries, 1)
+		suite.Equal(replacement, entries[0].WordID)
+	})
+}
+
+func (suite *ScheduleRepoTestSuite) TestScheduleRepository_UniqueDates() {
+	suite.Run("A date holds one entry", func() {
+		suite.Require().NoError(suite.repo.EnsureIndexes(), "Expected no error when creating indexes")
+		suite.NoError(suite.repo.SetEntry(&Entry{Date: "2025-08-07", WordID: primitive.NewObjectID(), UpdatedAt: time.Now()}))
+
+		_, err := suite.repo.collection.InsertOne(context.Background(), &Entry{Date: "2025-08-07", WordID: primitive.NewObjectID()})
+		suite.True(mongo.IsDuplicateKeyError(err), "Expected a second entry on the date to be rejected")
+	})
+}
+
+func (suite *ScheduleRepoTestSuite) TestScheduleRepository_Delete() {
+	suite.Run("By date and by word", func() {
+		wordID := primitive.NewObjectID()
+		_ = suite.repo.SetEntry(&Entry{Date: "2025-08-07", WordID: primitive.NewObjectID()})
+		_ = suite.repo.SetEntry(&Entry{Date: "2025-08-08", WordID: wordID})
+
+		suite.NoError(suite.repo.DeleteByDate("2025-08-07"))
+		suite.ErrorIs(suite.repo.DeleteByDate("2025-08-07"), ErrEntryNotFound)
+
+		suite.NoError(suite.repo.DeleteByWordIDFrom(wordID, "2025-08-06"))
+		_, err := suite.repo.FindByDate("2025-08-08")
+		suite.ErrorIs(err, ErrEntryNotFound)
+	})
+}
@@ -0,0 +1,301 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// backfillDays is how far ahead empty dates are filled from the unscheduled pool.
+	backfillDays = 14
+	// editorTimezone decides what "today" is for editors managing the schedule.
+	editorTimezone = "Asia/Seoul"
+)
+
+type Repository interface {
+	FindByDate(date string) (*Entry, error)
+	FindFrom(from string) ([]*Entry, error)
+	SetEntry(entry *Entry) error
+	DeleteByDate(date string) error
+	DeleteByWordIDFrom(wordID primitive.ObjectID, from string) error
+}
+
+type Words interface {
+	FindWord(id string) (*word.Word, error)
+	UndeliveredWords(excludeIDs []primitive.ObjectID, limit int) ([]*word.Word, error)
+	SetScheduled(wordID primitive.ObjectID, scheduled bool) error
+}
+
+type Service struct {
+	repository Repository
+	words      Words
+}
+
+func NewScheduleService(repo Repository, words Words) *Service {
+	return &Service{
+		repository: repo,
+		words:      words,
+	}
+}
+
+// Today returns the editors' current date.
+func Today(now time.Time) string {
+	location, err := time.LoadLocation(editorTimezone)
+	if err != nil {
+		location = time.UTC
+	}
+	return now.In(location).Format(dateLayout)
+}
+
+// Upcoming returns the words scheduled from today on, earliest first.
+func (s *Service) Upcoming(today string) ([]*ScheduledWord, error) {
+	entries, err := s.repository.FindFrom(today)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find schedule: %w", err)
+	}
+
+	scheduled := make([]*ScheduledWord, 0, len(entries))
+	for _, entry := range entries {
+		scheduledWord, err := s.words.FindWord(entry.WordID.Hex())
+		if err != nil {
+			log.Printf("Skipping schedule entry %s of missing word %s: %v", entry.Date, entry.WordID.Hex(), err)
+			continue
+		}
+		scheduled = append(scheduled, &ScheduledWord{Date: entry.Date, Word: scheduledWord})
+	}
+	return scheduled, nil
+}
+
+// Assign schedules a word on date, replacing the word scheduled there before.
+// The word must exist, must be approved, must not be deleted or delivered, and must not be scheduled on another upcoming date.
+func (s *Service) Assign(date, wordID, today string) error {
+	if err := validateUpcoming(date, today); err != nil {
+		return err
+	}
+	assigned, err := s.schedulableWord(wordID)
+	if err != nil {
+		return err
+	}
+
+	entries, err := s.repository.FindFrom(today)
+	if err != nil {
+		return fmt.Errorf("failed to find schedule: %w", err)
+	}
+	var replaced *Entry
+	for _, entry := range entries {
+		if entry.WordID == assigned.ID && entry.Date != date {
+			return fmt.Errorf("%w: %s", ErrAlreadyScheduled, entry.Date)
+		}
+		if entry.Date == date {
+			replaced = entry
+		}
+	}
+
+	if err := s.repository.SetEntry(&Entry{Date: date, WordID: assigned.ID, UpdatedAt: time.Now()}); err != nil {
+		return err
+	}
+	if replaced != nil && replaced.WordID != assigned.ID {
+		s.syncStatus(replaced.WordID, false)
+	}
+	s.syncStatus(assigned.ID, true)
+	return nil
+}
+
+// Unassign clears date and back-fills the schedule so no upcoming date is left empty.
+func (s *Service) Unassign(date, today string) error {
+	if err := validateUpcoming(date, today); err != nil {
+		return err
+	}
+	unassigned, err := s.repository.FindByDate(date)
+	if err != nil && !errors.Is(err, ErrEntryNotFound) {
+		return err
+	}
+	if err := s.repository.DeleteByDate(date); err != nil {
+		return err
+	}
+	if unassigned != nil {
+		s.syncStatus(unassigned.WordID, false)
+	}
+
+	// Back-filling is best effort, the word has been unscheduled either way.
+	if _, err := s.Backfill(today); err != nil {
+		log.Printf("Failed to back-fill schedule: %v", err)
+	}
+	return nil
+}
+
+// Reorder schedules wordIDs on consecutive dates starting at start, moving words that were scheduled
+// elsewhere. Words that were on those dates go back to the pool and may be used to back-fill.
+func (s *Service) Reorder(start string, wordIDs []string, today string) error {
+	if err := validateUpcoming(start, today); err != nil {
+		return err
+	}
+	startDate, _ := parseDate(start)
+
+	ordered := make([]*word.Word, 0, len(wordIDs))
+	for i, wordID := range wordIDs {
+		if slices.Contains(wordIDs[:i], wordID) {
+			return fmt.Errorf("%w: %s", ErrDuplicateWord, wordID)
+		}
+		orderedWord, err := s.schedulableWord(wordID)
+		if err != nil {
+			return err
+		}
+		ordered = append(ordered, orderedWord)
+	}
+
+	before, err := s.repository.FindFrom(today)
+	if err != nil {
+		return fmt.Errorf("failed to find schedule: %w", err)
+	}
+
+	now := time.Now()
+	for i, orderedWord := range ordered {
+		if err := s.repository.DeleteByWordIDFrom(orderedWord.ID, today); err != nil {
+			return fmt.Errorf("failed to unschedule %s: %w", orderedWord.Text, err)
+		}
+		date := startDate.AddDate(0, 0, i).Format(dateLayout)
+		if err := s.repository.SetEntry(&Entry{Date: date, WordID: orderedWord.ID, UpdatedAt: now}); err != nil {
+			return fmt.Errorf("failed to schedule %s: %w", orderedWord.Text, err)
+		}
+		s.syncStatus(orderedWord.ID, true)
+	}
+
+	// Words pushed off their date by the new order are back in the pool.
+	after, err := s.repository.FindFrom(today)
+	if err != nil {
+		return fmt.Errorf("failed to find schedule: %w", err)
+	}
+	for _, entry := range before {
+		if !slices.ContainsFunc(after, func(e *Entry) bool { return e.WordID == entry.WordID }) {
+			s.syncStatus(entry.WordID, false)
+		}
+	}
+
+	if _, err := s.Backfill(today); err != nil {
+		log.Printf("Failed to back-fill schedule: %v", err)
+	}
+	return nil
+}
+
+// Backfill fills the empty dates of the next backfillDays days, starting today, with the oldest words
+// that are neither delivered nor scheduled. It returns the number of dates filled.
+func (s *Service) Backfill(today string) (int, error) {
+	todayDate, err := parseDate(today)
+	if err != nil {
+		return 0, err
+	}
+
+	entries, err := s.repository.FindFrom(today)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find schedule: %w", err)
+	}
+	taken := make(map[string]bool, len(entries))
+	reserved := make([]primitive.ObjectID, 0, len(entries))
+	for _, entry := range entries {
+		taken[entry.Date] = true
+		reserved = append(reserved, entry.WordID)
+	}
+
+	var empty []string
+	for i := 0; i < backfillDays; i++ {
+		if date := todayDate.AddDate(0, 0, i).Format(dateLayout); !taken[date] {
+			empty = append(empty, date)
+		}
+	}
+	if len(empty) == 0 {
+		return 0, nil
+	}
+
+	pool, err := s.words.UndeliveredWords(reserved, len(empty))
+	if err != nil {
+		return 0, fmt.Errorf("failed to find unscheduled words: %w", err)
+	}
+
+	now := time.Now()
+	for i, poolWord := range pool {
+		if err := s.repository.SetEntry(&Entry{Date: empty[i], WordID: poolWord.ID, UpdatedAt: now}); err != nil {
+			return i, fmt.Errorf("failed to schedule %s: %w", poolWord.Text, err)
+		}
+		s.syncStatus(poolWord.ID, true)
+	}
+	return len(pool), nil
+}
+
+// WordFor returns the word scheduled on date. It returns nil when nothing is scheduled on date or the
+// scheduled word has since been deleted; empty dates are filled by Backfill, not here.
+func (s *Service) WordFor(date string) (*word.Word, error) {
+	entry, err := s.repository.FindByDate(date)
+	if errors.Is(err, ErrEntryNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledWord, err := s.words.FindWord(entry.WordID.Hex())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scheduled word: %w", err)
+	}
+	if scheduledWord.IsDeleted() {
+		return nil, nil
+	}
+	return scheduledWord, nil
+}
+
+// ReservedWordIDs returns the words scheduled after date, which should not be handed out before their day.
+func (s *Service) ReservedWordIDs(date string) ([]primitive.ObjectID, error) {
+	entries, err := s.repository.FindFrom(date)
+	if err != nil {
+		return nil, err
+	}
+
+	var reserved []primitive.ObjectID
+	for _, entry := range entries {
+		if entry.Date > date {
+			reserved = append(reserved, entry.WordID)
+		}
+	}
+	return reserved, nil
+}
+
+func (s *Service) schedulableWord(wordID string) (*word.Word, error) {
+	schedulable, err := s.words.FindWord(wordID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrWordNotFound, wordID)
+	}
+	if schedulable.IsDeleted() {
+		return nil, fmt.Errorf("%w: %s", ErrWordDeleted, schedulable.Text)
+	}
+	if schedulable.IsDelivered {
+		return nil, fmt.Errorf("%w: %s", ErrWordDelivered, schedulable.Text)
+	}
+	if !schedulable.Status.Deliverable() {
+		return nil, fmt.Errorf("%w: %s is %s", ErrWordNotApproved, schedulable.Text, schedulable.Status)
+	}
+	return schedulable, nil
+}
+
+// syncStatus keeps the word's editorial status in line with the schedule. The schedule itself decides what is
+// delivered, so a failure here is only logged.
+func (s *Service) syncStatus(wordID primitive.ObjectID, scheduled bool) {
+	if err := s.words.SetScheduled(wordID, scheduled); err != nil {
+		log.Printf("Failed to update the status of scheduled word %s: %v", wordID.Hex(), err)
+	}
+}
+
+func validateUpcoming(date, today string) error {
+	if _, err := parseDate(date); err != nil {
+		return err
+	}
+	if date < today {
+		return ErrDateInPast
+	}
+	return nil
+}
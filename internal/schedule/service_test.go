@@ -0,0 +1,219 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const today = "2025-08-06"
+
+type ScheduleServiceTestSuite struct {
+	suite.Suite
+	mockRepo  *MockRepository
+	mockWords *MockWords
+	service   *Service
+}
+
+func (suite *ScheduleServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockRepository)
+	suite.mockWords = new(MockWords)
+	suite.service = NewScheduleService(suite.mockRepo, suite.mockWords)
+}
+
+func TestScheduleServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleServiceTestSuite))
+}
+
+func (suite *ScheduleServiceTestSuite) TestAssign_Success() {
+	// Given
+	assigned := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity", Status: word.StatusScheduled}
+	suite.mockWords.EXPECT().FindWord(assigned.ID.Hex()).Return(assigned, nil)
+	suite.mockRepo.EXPECT().FindFrom(today).Return([]*Entry{{Date: "2025-08-08", WordID: assigned.ID}}, nil)
+	suite.mockRepo.EXPECT().SetEntry(mock.MatchedBy(func(entry *Entry) bool {
+		return entry.Date == "2025-08-08" && entry.WordID == assigned.ID
+	})).Return(nil)
+	suite.mockWords.EXPECT().SetScheduled(assigned.ID, true).Return(nil)
+
+	// When
+	err := suite.service.Assign("2025-08-08", assigned.ID.Hex(), today)
+
+	// Then
+	suite.NoError(err, "Assigning a word to the date it is already on should succeed")
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *ScheduleServiceTestSuite) TestAssign_ReplacedWordGoesBackToApproved() {
+	// Given
+	assigned := &word.Word{ID: primitive.NewObjectID(), Text: "serendipity", Status: word.StatusApproved}
+	replaced := primitive.NewObjectID()
+	suite.mockWords.EXPECT().FindWord(assigned.ID.Hex()).Return(assigned, nil)
+	suite.mockRepo.EXPECT().FindFrom(today).Return([]*Entry{{Date: "2025-08-08", WordID: replaced}}, nil)
+	suite.mockRepo.EXPECT().SetEntry(mock.Anything).Return(nil)
+	suite.mockWords.EXPECT().SetScheduled(replaced, false).Return(nil)
+	suite.mockWords.EXPECT().SetScheduled(assigned.ID, true).Return(nil)
+
+	// When
+	err := suite.service.Assign("2025-08-08", assigned.ID.Hex(), today)
+
+	// Then
+	suite.NoError(err)
+	suite.mockWords.AssertExpectations(suite.T())
+}
+
+func (suite *ScheduleServiceTestSuite) TestAssign_Failures() {
+	deleted := &word.Word{ID: primitive.NewObjectID(), Text: "deleted", DeletedAt: time.Now()}
+	delivered := &word.Word{ID: primitive.NewObjectID(), Text: "delivered", IsDelivered: true}
+	scheduled := &word.Word{ID: primitive.NewObjectID(), Text: "scheduled", Status: word.StatusScheduled}
+	draft := &word.Word{ID: primitive.NewObjectID(), Text: "draft", Status: word.StatusDraft}
+	suite.mockWords.EXPECT().FindWord(deleted.ID.Hex()).Return(deleted, nil)
+	suite.mockWords.EXPECT().FindWord(draft.ID.Hex()).Return(draft, nil)
+	suite.mockWords.EXPECT().FindWord(delivered.ID.Hex()).Return(delivered, nil)
+	suite.mockWords.EXPECT().FindWord(scheduled.ID.Hex()).Return(scheduled, nil)
+	suite.mockRepo.EXPECT().FindFrom(today).Return([]*Entry{{Date: "2025-08-07", WordID: scheduled.ID}}, nil)
+
+	tests := []struct {
+		name    string
+		date    string
+		wordID  string
+		wantErr error
+	}{
+		{name: "Invalid Date", date: "08/08/2025", wordID: scheduled.ID.Hex(), wantErr: ErrInvalidDate},
+		{name: "Past Date", date: "2025-08-05", wordID: scheduled.ID.Hex(), wantErr: ErrDateInPast},
+		{name: "Deleted Word", date: "2025-08-08", wordID: deleted.ID.Hex(), wantErr: ErrWordDeleted},
+		{name: "Delivered Word", date: "2025-08-08", wordID: delivered.ID.Hex(), wantErr: ErrWordDelivered},
+		{name: "Not Approved", date: "2025-08-08", wordID: draft.ID.Hex(), wantErr: ErrWordNotApproved},
+		{name: "Scheduled Elsewhere", date: "2025-08-08", wordID: scheduled.ID.Hex(), wantErr: ErrAlreadyScheduled},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := suite.service.Assign(tt.date, tt.wordID, today)
+			suite.ErrorIs(err, tt.wantErr)
+		})
+	}
+	suite.mockRepo.AssertNotCalled(suite.T(), "SetEntry", mock.Anything)
+}
+
+func (suite *ScheduleServiceTestSuite) TestUnassign_BackfillsEmptyDates() {
+	// Given
+	kept := &Entry{Date: "2025-08-07", WordID: primitive.NewObjectID()}
+	unassigned := &Entry{Date: "2025-08-08", WordID: primitive.NewObjectID()}
+	pool := []*word.Word{{ID: primitive.NewObjectID()}, {ID: primitive.NewObjectID()}}
+	suite.mockRepo.EXPECT().FindByDate("2025-08-08").Return(unassigned, nil)
+	suite.mockRepo.EXPECT().DeleteByDate("2025-08-08").Return(nil)
+	suite.mockWords.EXPECT().SetScheduled(unassigned.WordID, false).Return(nil)
+	suite.mockRepo.EXPECT().FindFrom(today).Return([]*Entry{kept}, nil)
+	suite.mockWords.EXPECT().UndeliveredWords([]primitive.ObjectID{kept.WordID}, backfillDays-1).Return(pool, nil)
+	suite.mockRepo.EXPECT().SetEntry(mock.MatchedBy(func(entry *Entry) bool {
+		return entry.Date == today && entry.WordID == pool[0].ID
+	})).Return(nil).Once()
+	suite.mockRepo.EXPECT().SetEntry(mock.MatchedBy(func(entry *Entry) bool {
+		return entry.Date == "2025-08-08" && entry.WordID == pool[1].ID
+	})).Return(nil).Once()
+	suite.mockWords.EXPECT().SetScheduled(pool[0].ID, true).Return(nil)
+	suite.mockWords.EXPECT().SetScheduled(pool[1].ID, true).Return(nil)
+
+	// When
+	err := suite.service.Unassign("2025-08-08", today)
+
+	// Then
+	suite.NoError(err)
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockWords.AssertExpectations(suite.T())
+}
+
+func (suite *ScheduleServiceTestSuite) TestReorder_MovesWordsToConsecutiveDates() {
+	// Given
+	first := &word.Word{ID: primitive.NewObjectID(), Status: word.StatusApproved}
+	second := &word.Word{ID: primitive.NewObjectID(), Status: word.StatusScheduled}
+	suite.mockWords.EXPECT().FindWord(first.ID.Hex()).Return(first, nil)
+	suite.mockWords.EXPECT().FindWord(second.ID.Hex()).Return(second, nil)
+	suite.mockRepo.EXPECT().DeleteByWordIDFrom(first.ID, today).Return(nil)
+	suite.mockRepo.EXPECT().DeleteByWordIDFrom(second.ID, today).Return(nil)
+	suite.mockRepo.EXPECT().SetEntry(mock.MatchedBy(func(entry *Entry) bool {
+		return entry.Date == "2025-08-10" && entry.WordID == first.ID
+	})).Return(nil).Once()
+	suite.mockRepo.EXPECT().SetEntry(mock.MatchedBy(func(entry *Entry) bool {
+		return entry.Date == "2025-08-11" && entry.WordID == second.ID
+	})).Return(nil).Once()
+	displaced := &Entry{Date: "2025-08-10", WordID: primitive.NewObjectID()}
+	suite.mockRepo.EXPECT().FindFrom(today).Return([]*Entry{displaced}, nil).Once()
+	suite.mockRepo.EXPECT().FindFrom(today).Return([]*Entry{{Date: "2025-08-10", WordID: first.ID}, {Date: "2025-08-11", WordID: second.ID}}, nil)
+	suite.mockWords.EXPECT().SetScheduled(first.ID, true).Return(nil)
+	suite.mockWords.EXPECT().SetScheduled(second.ID, true).Return(nil)
+	suite.mockWords.EXPECT().SetScheduled(displaced.WordID, false).Return(nil)
+	suite.mockWords.EXPECT().UndeliveredWords(mock.Anything, backfillDays-2).Return(nil, nil)
+
+	// When
+	err := suite.service.Reorder("2025-08-10", []string{first.ID.Hex(), second.ID.Hex()}, today)
+
+	// Then
+	suite.NoError(err)
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockWords.AssertExpectations(suite.T())
+}
+
+func (suite *ScheduleServiceTestSuite) TestReorder_DuplicateWord() {
+	// Given
+	first := &word.Word{ID: primitive.NewObjectID(), Status: word.StatusApproved}
+	suite.mockWords.EXPECT().FindWord(first.ID.Hex()).Return(first, nil)
+
+	// When
+	err := suite.service.Reorder(today, []string{first.ID.Hex(), first.ID.Hex()}, today)
+
+	// Then
+	suite.ErrorIs(err, ErrDuplicateWord)
+	suite.mockRepo.AssertNotCalled(suite.T(), "SetEntry", mock.Anything)
+}
+
+func (suite *ScheduleServiceTestSuite) TestWordFor() {
+	suite.Run("Scheduled", func() {
+		scheduled := &word.Word{ID: primitive.NewObjectID()}
+		suite.mockRepo.EXPECT().FindByDate("2025-09-01").Return(&Entry{Date: "2025-09-01", WordID: scheduled.ID}, nil)
+		suite.mockWords.EXPECT().FindWord(scheduled.ID.Hex()).Return(scheduled, nil)
+
+		found, err := suite.service.WordFor("2025-09-01")
+		suite.NoError(err)
+		suite.Equal(scheduled, found)
+	})
+
+	suite.Run("Deleted Since", func() {
+		deleted := &word.Word{ID: primitive.NewObjectID(), DeletedAt: time.Now()}
+		suite.mockRepo.EXPECT().FindByDate("2025-09-02").Return(&Entry{Date: "2025-09-02", WordID: deleted.ID}, nil)
+		suite.mockWords.EXPECT().FindWord(deleted.ID.Hex()).Return(deleted, nil)
+
+		found, err := suite.service.WordFor("2025-09-02")
+		suite.NoError(err)
+		suite.Nil(found)
+	})
+
+	suite.Run("Nothing Scheduled", func() {
+		suite.mockRepo.EXPECT().FindByDate("2025-09-03").Return(nil, ErrEntryNotFound)
+
+		found, err := suite.service.WordFor("2025-09-03")
+		suite.NoError(err)
+		suite.Nil(found)
+		suite.mockRepo.AssertNotCalled(suite.T(), "SetEntry", mock.Anything)
+	})
+}
+
+func (suite *ScheduleServiceTestSuite) TestReservedWordIDs() {
+	// Given
+	later := primitive.NewObjectID()
+	suite.mockRepo.EXPECT().FindFrom(today).Return([]*Entry{
+		{Date: today, WordID: primitive.NewObjectID()},
+		{Date: "2025-08-07", WordID: later},
+	}, nil)
+
+	// When
+	reserved, err := suite.service.ReservedWordIDs(today)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal([]primitive.ObjectID{later}, reserved)
+}
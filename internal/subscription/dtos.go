@@ -1,6 +1,28 @@
 package subscription
 
+import (
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
 type SaveSubscriptionDto struct {
 	Username string `json:"username" validate:"required"`
 	Email    string `json:"email" validate:"required,email"`
 }
+
+type UpdatePreferencesDto struct {
+	Frequency    Frequency      `json:"frequency" validate:"required"`
+	Days         []time.Weekday `json:"days,omitempty"`
+	TimesPerWeek int            `json:"times_per_week,omitempty"`
+	SendTime     string         `json:"send_time" validate:"required"`
+	Timezone     string         `json:"timezone" validate:"required"`
+	PausedFrom   string         `json:"paused_from,omitempty"`
+	PausedUntil  string         `json:"paused_until,omitempty"`
+	Levels       []word.Level   `json:"levels,omitempty"`
+	Tags         []string       `json:"tags,omitempty"`
+}
+
+type UpdateProfileDto struct {
+	Username string `json:"username" validate:"required"`
+}
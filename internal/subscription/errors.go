@@ -7,4 +7,10 @@ var (
 	ErrAlreadyVerified      = errors.New("email is already verified")
 	ErrRequestTooSoon       = errors.New("verification request sent too recently")
 	ErrVerificationBanned   = errors.New("account is banned from verification attempts")
+	ErrInvalidPreferences   = errors.New("invalid delivery preferences")
+	ErrInvalidProfile       = errors.New("username must not be empty")
+	ErrDeliveryClaimed      = errors.New("delivery was already claimed by another run")
+	ErrManageLinkUsed       = errors.New("manage link is invalid or has already been used")
+	ErrDeliveryComplained   = errors.New("delivery is suspended after a spam complaint")
+	ErrNotSuspended         = errors.New("delivery is not suspended by a bounce or complaint")
 )
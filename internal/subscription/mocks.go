@@ -5,6 +5,8 @@
 package subscription
 
 import (
+	"time"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -35,6 +37,63 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// ClaimDelivery provides a mock function for the type MockRepository
+func (_mock *MockRepository) ClaimDelivery(subscription *Subscription, claimedAt time.Time) error {
+	ret := _mock.Called(subscription, claimedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*Subscription, time.Time) error); ok {
+		r0 = returnFunc(subscription, claimedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_ClaimDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDelivery'
+type MockRepository_ClaimDelivery_Call struct {
+	*mock.Call
+}
+
+// ClaimDelivery is a helper method to define mock.On call
+//   - subscription *Subscription
+//   - claimedAt time.Time
+func (_e *MockRepository_Expecter) ClaimDelivery(subscription interface{}, claimedAt interface{}) *MockRepository_ClaimDelivery_Call {
+	return &MockRepository_ClaimDelivery_Call{Call: _e.mock.On("ClaimDelivery", subscription, claimedAt)}
+}
+
+func (_c *MockRepository_ClaimDelivery_Call) Run(run func(subscription *Subscription, claimedAt time.Time)) *MockRepository_ClaimDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Subscription
+		if args[0] != nil {
+			arg0 = args[0].(*Subscription)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_ClaimDelivery_Call) Return(err error) *MockRepository_ClaimDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_ClaimDelivery_Call) RunAndReturn(run func(subscription *Subscription, claimedAt time.Time) error) *MockRepository_ClaimDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByEmail provides a mock function for the type MockRepository
 func (_mock *MockRepository) FindByEmail(email string) (*Subscription, error) {
 	ret := _mock.Called(email)
@@ -97,6 +156,68 @@ func (_c *MockRepository_FindByEmail_Call) RunAndReturn(run func(email string) (
 	return _c
 }
 
+// FindById provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindById(id string) (*Subscription, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*Subscription, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *Subscription); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - id string
+func (_e *MockRepository_Expecter) FindById(id interface{}) *MockRepository_FindById_Call {
+	return &MockRepository_FindById_Call{Call: _e.mock.On("FindById", id)}
+}
+
+func (_c *MockRepository_FindById_Call) Run(run func(id string)) *MockRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindById_Call) Return(subscription *Subscription, err error) *MockRepository_FindById_Call {
+	_c.Call.Return(subscription, err)
+	return _c
+}
+
+func (_c *MockRepository_FindById_Call) RunAndReturn(run func(id string) (*Subscription, error)) *MockRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByIdAndVerificationCode provides a mock function for the type MockRepository
 func (_mock *MockRepository) FindByIdAndVerificationCode(id string, code string) (*Subscription, error) {
 	ret := _mock.Called(id, code)
@@ -165,6 +286,118 @@ func (_c *MockRepository_FindByIdAndVerificationCode_Call) RunAndReturn(run func
 	return _c
 }
 
+// FindDeliverable provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindDeliverable() ([]*Subscription, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDeliverable")
+	}
+
+	var r0 []*Subscription
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]*Subscription, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []*Subscription); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Subscription)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindDeliverable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeliverable'
+type MockRepository_FindDeliverable_Call struct {
+	*mock.Call
+}
+
+// FindDeliverable is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) FindDeliverable() *MockRepository_FindDeliverable_Call {
+	return &MockRepository_FindDeliverable_Call{Call: _e.mock.On("FindDeliverable")}
+}
+
+func (_c *MockRepository_FindDeliverable_Call) Run(run func()) *MockRepository_FindDeliverable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindDeliverable_Call) Return(subscriptions []*Subscription, err error) *MockRepository_FindDeliverable_Call {
+	_c.Call.Return(subscriptions, err)
+	return _c
+}
+
+func (_c *MockRepository_FindDeliverable_Call) RunAndReturn(run func() ([]*Subscription, error)) *MockRepository_FindDeliverable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RedeemManageNonce provides a mock function for the type MockRepository
+func (_mock *MockRepository) RedeemManageNonce(id string, nonce string) error {
+	ret := _mock.Called(id, nonce)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RedeemManageNonce")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(id, nonce)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_RedeemManageNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RedeemManageNonce'
+type MockRepository_RedeemManageNonce_Call struct {
+	*mock.Call
+}
+
+// RedeemManageNonce is a helper method to define mock.On call
+//   - id string
+//   - nonce string
+func (_e *MockRepository_Expecter) RedeemManageNonce(id interface{}, nonce interface{}) *MockRepository_RedeemManageNonce_Call {
+	return &MockRepository_RedeemManageNonce_Call{Call: _e.mock.On("RedeemManageNonce", id, nonce)}
+}
+
+func (_c *MockRepository_RedeemManageNonce_Call) Run(run func(id string, nonce string)) *MockRepository_RedeemManageNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_RedeemManageNonce_Call) Return(err error) *MockRepository_RedeemManageNonce_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_RedeemManageNonce_Call) RunAndReturn(run func(id string, nonce string) error) *MockRepository_RedeemManageNonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveSubscription provides a mock function for the type MockRepository
 func (_mock *MockRepository) SaveSubscription(subscription *Subscription) (*Subscription, error) {
 	ret := _mock.Called(subscription)
@@ -227,6 +460,108 @@ func (_c *MockRepository_SaveSubscription_Call) RunAndReturn(run func(subscripti
 	return _c
 }
 
+// UpdateDeliveryState provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateDeliveryState(subscription *Subscription) error {
+	ret := _mock.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDeliveryState")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*Subscription) error); ok {
+		r0 = returnFunc(subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateDeliveryState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeliveryState'
+type MockRepository_UpdateDeliveryState_Call struct {
+	*mock.Call
+}
+
+// UpdateDeliveryState is a helper method to define mock.On call
+//   - subscription *Subscription
+func (_e *MockRepository_Expecter) UpdateDeliveryState(subscription interface{}) *MockRepository_UpdateDeliveryState_Call {
+	return &MockRepository_UpdateDeliveryState_Call{Call: _e.mock.On("UpdateDeliveryState", subscription)}
+}
+
+func (_c *MockRepository_UpdateDeliveryState_Call) Run(run func(subscription *Subscription)) *MockRepository_UpdateDeliveryState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Subscription
+		if args[0] != nil {
+			arg0 = args[0].(*Subscription)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateDeliveryState_Call) Return(err error) *MockRepository_UpdateDeliveryState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateDeliveryState_Call) RunAndReturn(run func(subscription *Subscription) error) *MockRepository_UpdateDeliveryState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDeliveryStatus provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateDeliveryStatus(subscription *Subscription) error {
+	ret := _mock.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDeliveryStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*Subscription) error); ok {
+		r0 = returnFunc(subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateDeliveryStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDeliveryStatus'
+type MockRepository_UpdateDeliveryStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateDeliveryStatus is a helper method to define mock.On call
+//   - subscription *Subscription
+func (_e *MockRepository_Expecter) UpdateDeliveryStatus(subscription interface{}) *MockRepository_UpdateDeliveryStatus_Call {
+	return &MockRepository_UpdateDeliveryStatus_Call{Call: _e.mock.On("UpdateDeliveryStatus", subscription)}
+}
+
+func (_c *MockRepository_UpdateDeliveryStatus_Call) Run(run func(subscription *Subscription)) *MockRepository_UpdateDeliveryStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Subscription
+		if args[0] != nil {
+			arg0 = args[0].(*Subscription)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateDeliveryStatus_Call) Return(err error) *MockRepository_UpdateDeliveryStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateDeliveryStatus_Call) RunAndReturn(run func(subscription *Subscription) error) *MockRepository_UpdateDeliveryStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSubscription provides a mock function for the type MockRepository
 func (_mock *MockRepository) UpdateSubscription(subscription *Subscription) error {
 	ret := _mock.Called(subscription)
@@ -278,6 +613,57 @@ func (_c *MockRepository_UpdateSubscription_Call) RunAndReturn(run func(subscrip
 	return _c
 }
 
+// UpdateUsername provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateUsername(subscription *Subscription) error {
+	ret := _mock.Called(subscription)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateUsername")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*Subscription) error); ok {
+		r0 = returnFunc(subscription)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUsername'
+type MockRepository_UpdateUsername_Call struct {
+	*mock.Call
+}
+
+// UpdateUsername is a helper method to define mock.On call
+//   - subscription *Subscription
+func (_e *MockRepository_Expecter) UpdateUsername(subscription interface{}) *MockRepository_UpdateUsername_Call {
+	return &MockRepository_UpdateUsername_Call{Call: _e.mock.On("UpdateUsername", subscription)}
+}
+
+func (_c *MockRepository_UpdateUsername_Call) Run(run func(subscription *Subscription)) *MockRepository_UpdateUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Subscription
+		if args[0] != nil {
+			arg0 = args[0].(*Subscription)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateUsername_Call) Return(err error) *MockRepository_UpdateUsername_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateUsername_Call) RunAndReturn(run func(subscription *Subscription) error) *MockRepository_UpdateUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockMailSender creates a new instance of MockMailSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockMailSender(t interface {
@@ -305,6 +691,69 @@ func (_m *MockMailSender) EXPECT() *MockMailSender_Expecter {
 	return &MockMailSender_Expecter{mock: &_m.Mock}
 }
 
+// SendManageLinkEmail provides a mock function for the type MockMailSender
+func (_mock *MockMailSender) SendManageLinkEmail(email string, username string, link string) error {
+	ret := _mock.Called(email, username, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendManageLinkEmail")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = returnFunc(email, username, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockMailSender_SendManageLinkEmail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendManageLinkEmail'
+type MockMailSender_SendManageLinkEmail_Call struct {
+	*mock.Call
+}
+
+// SendManageLinkEmail is a helper method to define mock.On call
+//   - email string
+//   - username string
+//   - link string
+func (_e *MockMailSender_Expecter) SendManageLinkEmail(email interface{}, username interface{}, link interface{}) *MockMailSender_SendManageLinkEmail_Call {
+	return &MockMailSender_SendManageLinkEmail_Call{Call: _e.mock.On("SendManageLinkEmail", email, username, link)}
+}
+
+func (_c *MockMailSender_SendManageLinkEmail_Call) Run(run func(email string, username string, link string)) *MockMailSender_SendManageLinkEmail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockMailSender_SendManageLinkEmail_Call) Return(err error) *MockMailSender_SendManageLinkEmail_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockMailSender_SendManageLinkEmail_Call) RunAndReturn(run func(email string, username string, link string) error) *MockMailSender_SendManageLinkEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendVerificationEmail provides a mock function for the type MockMailSender
 func (_mock *MockMailSender) SendVerificationEmail(email string, username string, code string) error {
 	ret := _mock.Called(email, username, code)
@@ -1,6 +1,8 @@
 package subscription
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/Go-roro/wordrop/internal/common"
@@ -14,18 +16,41 @@ const (
 	banDuration             = 24 * time.Hour
 )
 
+type DeliveryStatus string
+
+const (
+	DeliveryActive  DeliveryStatus = "active"
+	DeliveryBounced DeliveryStatus = "bounced"
+	// DeliveryComplained is set when the recipient reports a mail as spam; only an admin can resume delivery.
+	DeliveryComplained DeliveryStatus = "complained"
+	// DeliveryUnsubscribed is set when the subscriber unsubscribes; verifying again resumes delivery.
+	DeliveryUnsubscribed DeliveryStatus = "unsubscribed"
+)
+
+// suspendedStatuses are the delivery statuses no mail is sent in.
+var suspendedStatuses = []DeliveryStatus{DeliveryBounced, DeliveryComplained, DeliveryUnsubscribed}
+
 type Subscription struct {
-	ID                   primitive.ObjectID `bson:"_id,omitempty"`
-	Username             string             `bson:"username" validate:"required"`
-	Email                string             `bson:"email" validate:"required,email"`
-	Verified             bool               `bson:"verified"`
-	VerificationAttempts int                `bson:"verification_attempts"`
-	LastVerifiedAt       time.Time          `bson:"last_verified_at"`
-	Banned               bool               `bson:"banned"`
-	BannedUntil          time.Time          `bson:"banned_until"`
-	VerificationCode     string             `bson:"verification_code"`
-	CreatedAt            time.Time          `bson:"created_at"`
-	UpdatedAt            time.Time          `bson:"updated_at"`
+	ID                   primitive.ObjectID  `bson:"_id,omitempty"`
+	Username             string              `bson:"username" validate:"required"`
+	Email                string              `bson:"email" validate:"required,email"`
+	Verified             bool                `bson:"verified"`
+	VerificationAttempts int                 `bson:"verification_attempts"`
+	LastVerifiedAt       time.Time           `bson:"last_verified_at"`
+	Banned               bool                `bson:"banned"`
+	BannedUntil          time.Time           `bson:"banned_until"`
+	VerificationCode     string              `bson:"verification_code"`
+	DeliveryStatus       DeliveryStatus      `bson:"delivery_status"`
+	SuspensionReason     string              `bson:"suspension_reason"`
+	SuspendedAt          time.Time           `bson:"suspended_at"`
+	TrackingOptOut       bool                `bson:"tracking_opt_out"`
+	Preferences          DeliveryPreferences `bson:"preferences"`
+	LastDeliveredAt      time.Time           `bson:"last_delivered_at"` // when the latest delivery was claimed, whether or not it was sent
+	DeliveryFailures     int                 `bson:"delivery_failures"` // sends that failed in a row
+	RetryDeliveryAt      time.Time           `bson:"retry_delivery_at"` // when a failed delivery is tried again, zero when none is
+	ManageNonce          string              `bson:"manage_nonce"`
+	CreatedAt            time.Time           `bson:"created_at"`
+	UpdatedAt            time.Time           `bson:"updated_at"`
 }
 
 func NewSubscription(username, email string) *Subscription {
@@ -38,11 +63,23 @@ func NewSubscription(username, email string) *Subscription {
 		Banned:               false,
 		BannedUntil:          time.Time{},
 		VerificationCode:     "",
+		DeliveryStatus:       DeliveryActive,
+		Preferences:          DefaultDeliveryPreferences(),
 	}
 }
 
+// IsDeliverable reports whether mail may be sent to the subscription.
+// Subscriptions stored before delivery status existed have an empty status and count as active.
+func (s *Subscription) IsDeliverable() bool {
+	return s.Verified && !slices.Contains(suspendedStatuses, s.DeliveryStatus)
+}
+
 func (s *Subscription) validateVerifiable() error {
-	if s.Verified && !s.Banned {
+	if s.DeliveryStatus == DeliveryComplained {
+		return ErrDeliveryComplained
+	}
+
+	if s.Verified && !s.Banned && s.IsDeliverable() {
 		return ErrAlreadyVerified
 	}
 
@@ -75,12 +112,37 @@ func (s *Subscription) ban() {
 	s.Verified = false
 }
 
-func (s *Subscription) refreshVerificationCode() {
-	token, _ := common.GenerateRandomToken(verificationCodeLength)
+func (s *Subscription) refreshVerificationCode() error {
+	token, err := common.GenerateRandomToken(verificationCodeLength)
+	if err != nil {
+		return fmt.Errorf("failed to generate verification code: %w", err)
+	}
 	s.VerificationCode = token
+	return nil
 }
 
 func (s *Subscription) verificationMailSent() {
 	s.VerificationAttempts++
 	s.LastVerifiedAt = time.Now()
 }
+
+func (s *Subscription) suspendDelivery(status DeliveryStatus, reason string) {
+	s.DeliveryStatus = status
+	s.SuspensionReason = reason
+	s.SuspendedAt = time.Now()
+}
+
+func (s *Subscription) resumeDelivery() {
+	s.DeliveryStatus = DeliveryActive
+	s.SuspensionReason = ""
+	s.SuspendedAt = time.Time{}
+}
+
+func (s *Subscription) refreshManageNonce() error {
+	nonce, err := common.GenerateRandomToken(verificationCodeLength)
+	if err != nil {
+		return fmt.Errorf("failed to generate manage link nonce: %w", err)
+	}
+	s.ManageNonce = nonce
+	return nil
+}
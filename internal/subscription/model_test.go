@@ -28,6 +28,14 @@ func TestSubscription_ValidateVerifiable(t *testing.T) {
 			},
 			wantErr: ErrRequestTooSoon,
 		},
+		{
+			name: "Spam Complaint",
+			sub: &Subscription{
+				Verified:       true,
+				DeliveryStatus: DeliveryComplained,
+			},
+			wantErr: ErrDeliveryComplained,
+		},
 		{
 			name: "Banned User",
 			sub: &Subscription{
@@ -143,7 +151,7 @@ func TestSubscription_RefreshVerificationCode(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.subscription.refreshVerificationCode()
+			assert.NoError(t, tt.subscription.refreshVerificationCode())
 			assert.NotEmpty(t, tt.subscription.VerificationCode)
 			assert.Equal(t, verificationCodeLength, len(tt.subscription.VerificationCode))
 		})
@@ -172,3 +180,43 @@ func TestSubscription_VerificationMailSent(t *testing.T) {
 		})
 	}
 }
+
+func TestSubscription_IsDeliverable(t *testing.T) {
+	tests := []struct {
+		name         string
+		subscription *Subscription
+		deliverable  bool
+	}{
+		{
+			name:         "Verified Active",
+			subscription: &Subscription{Verified: true, DeliveryStatus: DeliveryActive},
+			deliverable:  true,
+		},
+		{
+			name:         "Verified Without Status",
+			subscription: &Subscription{Verified: true},
+			deliverable:  true,
+		},
+		{
+			name:         "Not Verified",
+			subscription: &Subscription{Verified: false, DeliveryStatus: DeliveryActive},
+			deliverable:  false,
+		},
+		{
+			name:         "Bounced",
+			subscription: &Subscription{Verified: true, DeliveryStatus: DeliveryBounced},
+			deliverable:  false,
+		},
+		{
+			name:         "Complained",
+			subscription: &Subscription{Verified: true, DeliveryStatus: DeliveryComplained},
+			deliverable:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.deliverable, tt.subscription.IsDeliverable())
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package subscription
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+)
+
+type Frequency string
+
+const (
+	FrequencyDaily    Frequency = "daily"
+	FrequencyWeekdays Frequency = "weekdays"
+	// FrequencyWeekly sends on the chosen Days, or on TimesPerWeek days spread over the week.
+	FrequencyWeekly Frequency = "weekly"
+)
+
+const (
+	defaultSendTime = "08:00"
+	defaultTimezone = "Asia/Seoul"
+	sendTimeLayout  = "15:04"
+	pauseDateLayout = "2006-01-02"
+)
+
+type DeliveryPreferences struct {
+	Frequency    Frequency      `bson:"frequency"`
+	Days         []time.Weekday `bson:"days,omitempty"`
+	TimesPerWeek int            `bson:"times_per_week,omitempty"`
+	SendTime     string         `bson:"send_time"` // HH:MM in Timezone
+	Timezone     string         `bson:"timezone"`  // IANA name, e.g. Asia/Seoul
+	// PausedFrom and PausedUntil are inclusive YYYY-MM-DD local dates; delivery resumes the day after PausedUntil.
+	PausedFrom  string `bson:"paused_from"`
+	PausedUntil string `bson:"paused_until"`
+	// Levels and Tags restrict delivered words to the chosen track; empty means every word.
+	Levels []word.Level `bson:"levels,omitempty"`
+	Tags   []string     `bson:"tags,omitempty"`
+}
+
+func DefaultDeliveryPreferences() DeliveryPreferences {
+	return DeliveryPreferences{
+		Frequency: FrequencyDaily,
+		SendTime:  defaultSendTime,
+		Timezone:  defaultTimezone,
+	}
+}
+
+// normalized fills in defaults for subscriptions stored before preferences existed.
+func (p DeliveryPreferences) normalized() DeliveryPreferences {
+	defaults := DefaultDeliveryPreferences()
+	if p.Frequency == "" {
+		p.Frequency = defaults.Frequency
+	}
+	if p.SendTime == "" {
+		p.SendTime = defaults.SendTime
+	}
+	if p.Timezone == "" {
+		p.Timezone = defaults.Timezone
+	}
+	return p
+}
+
+// validate checks the preferences and stores the send time as zero-padded HH:MM, however it was written.
+func (p *DeliveryPreferences) validate() error {
+	switch p.Frequency {
+	case FrequencyDaily, FrequencyWeekdays:
+	case FrequencyWeekly:
+		if len(p.Days) == 0 && (p.TimesPerWeek < 1 || p.TimesPerWeek > 7) {
+			return fmt.Errorf("%w: weekly frequency needs days or times per week between 1 and 7", ErrInvalidPreferences)
+		}
+		seen := map[time.Weekday]bool{}
+		for _, day := range p.Days {
+			if day < time.Sunday || day > time.Saturday || seen[day] {
+				return fmt.Errorf("%w: invalid or duplicated day %d", ErrInvalidPreferences, day)
+			}
+			seen[day] = true
+		}
+	default:
+		return fmt.Errorf("%w: unknown frequency %q", ErrInvalidPreferences, p.Frequency)
+	}
+
+	sendTime, err := time.Parse(sendTimeLayout, p.SendTime)
+	if err != nil {
+		return fmt.Errorf("%w: send time must be HH:MM", ErrInvalidPreferences)
+	}
+	p.SendTime = sendTime.Format(sendTimeLayout)
+	if _, err := time.LoadLocation(p.Timezone); err != nil {
+		return fmt.Errorf("%w: unknown timezone %q", ErrInvalidPreferences, p.Timezone)
+	}
+
+	for _, date := range []string{p.PausedFrom, p.PausedUntil} {
+		if _, err := time.Parse(pauseDateLayout, date); date != "" && err != nil {
+			return fmt.Errorf("%w: pause dates must be YYYY-MM-DD", ErrInvalidPreferences)
+		}
+	}
+	if p.PausedFrom != "" && p.PausedUntil != "" && p.PausedUntil < p.PausedFrom {
+		return fmt.Errorf("%w: pause must end after it starts", ErrInvalidPreferences)
+	}
+
+	for _, level := range p.Levels {
+		if !slices.Contains(word.Levels, level) {
+			return fmt.Errorf("%w: unknown level %q", ErrInvalidPreferences, level)
+		}
+	}
+	return nil
+}
+
+// deliveryDays returns the weekdays mail is sent on.
+func (p DeliveryPreferences) deliveryDays() map[time.Weekday]bool {
+	days := map[time.Weekday]bool{}
+	switch p.Frequency {
+	case FrequencyWeekdays:
+		for day := time.Monday; day <= time.Friday; day++ {
+			days[day] = true
+		}
+	case FrequencyWeekly:
+		for _, day := range p.Days {
+			days[day] = true
+		}
+		if len(p.Days) == 0 {
+			// Spread the sends evenly over the week starting on Monday, e.g. 3 times is Mon, Wed, Fri.
+			for i := 0; i < p.TimesPerWeek; i++ {
+				days[time.Weekday((1+i*7/p.TimesPerWeek)%7)] = true
+			}
+		}
+	default:
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			days[day] = true
+		}
+	}
+	return days
+}
+
+// isPausedOn reports whether the local date falls within the pause window. Either end may be open.
+func (p DeliveryPreferences) isPausedOn(localDate string) bool {
+	if p.PausedFrom == "" && p.PausedUntil == "" {
+		return false
+	}
+	if p.PausedFrom != "" && localDate < p.PausedFrom {
+		return false
+	}
+	if p.PausedUntil != "" && localDate > p.PausedUntil {
+		return false
+	}
+	return true
+}
+
+// LocalDate returns the subscriber's calendar date at now, e.g. 2025-08-06.
+func (s *Subscription) LocalDate(now time.Time) string {
+	location, err := time.LoadLocation(s.Preferences.normalized().Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	return now.In(location).Format(pauseDateLayout)
+}
+
+// IsPausedAt reports whether now falls within the pause the subscriber set, on their local date.
+func (s *Subscription) IsPausedAt(now time.Time) bool {
+	return s.Preferences.isPausedOn(s.LocalDate(now))
+}
+
+// IsDueAt reports whether the subscription should receive a word at now: it is deliverable,
+// now is a delivery day past the preferred local send time, not paused, and nothing was sent yet that local day
+// or a failed delivery is due to be retried.
+func (s *Subscription) IsDueAt(now time.Time) bool {
+	if !s.IsDeliverable() {
+		return false
+	}
+
+	preferences := s.Preferences.normalized()
+	location, err := time.LoadLocation(preferences.Timezone)
+	if err != nil {
+		return false
+	}
+
+	local := now.In(location)
+	today := local.Format(pauseDateLayout)
+	if !preferences.deliveryDays()[local.Weekday()] || preferences.isPausedOn(today) {
+		return false
+	}
+
+	// Compared as times of day, since send times stored before they were padded may read "8:00".
+	sendTime, err := time.Parse(sendTimeLayout, preferences.SendTime)
+	if err != nil {
+		return false
+	}
+	if local.Hour()*60+local.Minute() < sendTime.Hour()*60+sendTime.Minute() {
+		return false
+	}
+	if !s.RetryDeliveryAt.IsZero() {
+		// A failed delivery is due again once its backoff is over, even on the day it was claimed.
+		return !now.Before(s.RetryDeliveryAt)
+	}
+
+	return s.LastDeliveredAt.IsZero() || s.LastDeliveredAt.In(location).Format(pauseDateLayout) != today
+}
@@ -0,0 +1,166 @@
+package subscription
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/word"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryPreferences_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		preferences DeliveryPreferences
+		wantErr     bool
+	}{
+		{name: "Default", preferences: DefaultDeliveryPreferences()},
+		{
+			name:        "Weekly With Days",
+			preferences: DeliveryPreferences{Frequency: FrequencyWeekly, Days: []time.Weekday{time.Monday, time.Thursday}, SendTime: "07:30", Timezone: "America/New_York"},
+		},
+		{
+			name:        "Weekly Without Days Or Times",
+			preferences: DeliveryPreferences{Frequency: FrequencyWeekly, SendTime: "07:30", Timezone: "UTC"},
+			wantErr:     true,
+		},
+		{
+			name:        "Unknown Frequency",
+			preferences: DeliveryPreferences{Frequency: "hourly", SendTime: "07:30", Timezone: "UTC"},
+			wantErr:     true,
+		},
+		{
+			name:        "Invalid Send Time",
+			preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "25:00", Timezone: "UTC"},
+			wantErr:     true,
+		},
+		{
+			name:        "Unknown Timezone",
+			preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "07:30", Timezone: "Mars/Olympus"},
+			wantErr:     true,
+		},
+		{
+			name:        "Levels",
+			preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "07:30", Timezone: "UTC", Levels: []word.Level{word.LevelA1, word.LevelA2}},
+		},
+		{
+			name:        "Unknown Level",
+			preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "07:30", Timezone: "UTC", Levels: []word.Level{"Z9"}},
+			wantErr:     true,
+		},
+		{
+			name:        "Pause Ends Before It Starts",
+			preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "07:30", Timezone: "UTC", PausedFrom: "2025-08-10", PausedUntil: "2025-08-01"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.preferences.validate()
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidPreferences), "Expected ErrInvalidPreferences, got %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeliveryPreferences_ValidatePadsSendTime(t *testing.T) {
+	preferences := DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "8:05", Timezone: "UTC"}
+
+	assert.NoError(t, preferences.validate())
+	assert.Equal(t, "08:05", preferences.SendTime)
+}
+
+func TestDeliveryPreferences_DeliveryDays(t *testing.T) {
+	preferences := DeliveryPreferences{Frequency: FrequencyWeekly, TimesPerWeek: 3}
+	assert.Equal(t, map[time.Weekday]bool{time.Monday: true, time.Wednesday: true, time.Friday: true}, preferences.deliveryDays())
+
+	preferences = DeliveryPreferences{Frequency: FrequencyWeekdays}
+	assert.Len(t, preferences.deliveryDays(), 5)
+	assert.False(t, preferences.deliveryDays()[time.Saturday])
+}
+
+func TestSubscription_IsDueAt(t *testing.T) {
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+	// Wednesday 2025-08-06 09:00 in Seoul.
+	now := time.Date(2025, 8, 6, 9, 0, 0, 0, seoul)
+
+	tests := []struct {
+		name string
+		sub  *Subscription
+		want bool
+	}{
+		{
+			name: "Default Preferences After Send Time",
+			sub:  &Subscription{Verified: true},
+			want: true,
+		},
+		{
+			name: "Not Verified",
+			sub:  &Subscription{Verified: false},
+			want: false,
+		},
+		{
+			name: "Before Send Time",
+			sub:  &Subscription{Verified: true, Preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "10:00", Timezone: "Asia/Seoul"}},
+			want: false,
+		},
+		{
+			name: "Unpadded Send Time",
+			sub:  &Subscription{Verified: true, Preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "8:00", Timezone: "Asia/Seoul"}},
+			want: true,
+		},
+		{
+			name: "Send Time In Another Timezone",
+			sub:  &Subscription{Verified: true, Preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "19:00", Timezone: "America/New_York"}},
+			want: true,
+		},
+		{
+			name: "Not A Delivery Day",
+			sub:  &Subscription{Verified: true, Preferences: DeliveryPreferences{Frequency: FrequencyWeekly, Days: []time.Weekday{time.Monday}, SendTime: "08:00", Timezone: "Asia/Seoul"}},
+			want: false,
+		},
+		{
+			name: "Paused",
+			sub:  &Subscription{Verified: true, Preferences: DeliveryPreferences{Frequency: FrequencyDaily, SendTime: "08:00", Timezone: "Asia/Seoul", PausedFrom: "2025-08-01", PausedUntil: "2025-08-06"}},
+			want: false,
+		},
+		{
+			name: "Already Delivered Today",
+			sub:  &Subscription{Verified: true, LastDeliveredAt: now.Add(-30 * time.Minute)},
+			want: false,
+		},
+		{
+			name: "Delivered Yesterday",
+			sub:  &Subscription{Verified: true, LastDeliveredAt: now.Add(-24 * time.Hour)},
+			want: true,
+		},
+		{
+			name: "Failed Today And Waiting To Retry",
+			sub:  &Subscription{Verified: true, LastDeliveredAt: now.Add(-30 * time.Minute), RetryDeliveryAt: now.Add(time.Minute)},
+			want: false,
+		},
+		{
+			name: "Failed Today And Due For A Retry",
+			sub:  &Subscription{Verified: true, LastDeliveredAt: now.Add(-30 * time.Minute), RetryDeliveryAt: now.Add(-time.Minute)},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.sub.IsDueAt(now))
+		})
+	}
+}
+
+func TestSubscription_LocalDate(t *testing.T) {
+	now := time.Date(2025, 8, 6, 20, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2025-08-07", (&Subscription{}).LocalDate(now), "Default timezone is Asia/Seoul")
+	assert.Equal(t, "2025-08-06", (&Subscription{Preferences: DeliveryPreferences{Timezone: "America/New_York"}}).LocalDate(now))
+}
@@ -40,6 +40,28 @@ func (r *MongoRepository) FindByEmail(email string) (*Subscription, error) {
 	return subscription, nil
 }
 
+func (r *MongoRepository) FindById(id string) (*Subscription, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	result := r.collection.FindOne(ctx, bson.M{"_id": objectId})
+	if errors.Is(result.Err(), mongo.ErrNoDocuments) {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	subscription := &Subscription{}
+	if err := result.Decode(subscription); err != nil {
+		return nil, fmt.Errorf("failed to decode subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
 func (r *MongoRepository) SaveSubscription(subscription *Subscription) (*Subscription, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -69,6 +91,93 @@ func (r *MongoRepository) UpdateSubscription(subscription *Subscription) error {
 	return nil
 }
 
+// ClaimDelivery sets the subscription delivered at claimedAt and clears any pending retry, unless another run
+// claimed a delivery since the subscription was read, in which case it returns ErrDeliveryClaimed.
+func (r *MongoRepository) ClaimDelivery(subscription *Subscription, claimedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Subscriptions that never had a delivery may be stored without the field at all.
+	lastDeliveredAt := any(subscription.LastDeliveredAt)
+	if subscription.LastDeliveredAt.IsZero() {
+		lastDeliveredAt = bson.M{"$in": bson.A{time.Time{}, nil}}
+	}
+	filter := bson.M{"_id": subscription.ID, "last_delivered_at": lastDeliveredAt}
+	update := bson.M{"$set": bson.M{
+		"last_delivered_at": claimedAt,
+		"retry_delivery_at": time.Time{},
+		"updated_at":        time.Now(),
+	}}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to claim delivery for subscription %s: %w", subscription.ID.Hex(), err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrDeliveryClaimed
+	}
+	return nil
+}
+
+// UpdateDeliveryState saves when the subscription was last delivered and the state of its failed sends,
+// leaving the rest of the document, which may have changed while the word was being sent, as it is.
+func (r *MongoRepository) UpdateDeliveryState(subscription *Subscription) error {
+	return r.updateFields(subscription, bson.M{
+		"last_delivered_at": subscription.LastDeliveredAt,
+		"delivery_failures": subscription.DeliveryFailures,
+		"retry_delivery_at": subscription.RetryDeliveryAt,
+	})
+}
+
+// UpdateDeliveryStatus saves whether deliveries are suspended, and why, leaving the rest of the document as it is.
+func (r *MongoRepository) UpdateDeliveryStatus(subscription *Subscription) error {
+	return r.updateFields(subscription, bson.M{
+		"delivery_status":   subscription.DeliveryStatus,
+		"suspension_reason": subscription.SuspensionReason,
+		"suspended_at":      subscription.SuspendedAt,
+	})
+}
+
+// UpdateUsername saves the subscriber's name, leaving the rest of the document as it is.
+func (r *MongoRepository) UpdateUsername(subscription *Subscription) error {
+	return r.updateFields(subscription, bson.M{"username": subscription.Username})
+}
+
+func (r *MongoRepository) updateFields(subscription *Subscription, fields bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	subscription.UpdatedAt = time.Now()
+	fields["updated_at"] = subscription.UpdatedAt
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": subscription.ID}, bson.M{"$set": fields}); err != nil {
+		return fmt.Errorf("failed to execute update for subscription %s: %w", subscription.ID.Hex(), err)
+	}
+	return nil
+}
+
+// RedeemManageNonce clears the nonce of a manage link so it can only be used once. The nonce is checked and
+// cleared in one update, so of two requests with the same link only one succeeds; the other, like any request
+// with a link already used or replaced, gets ErrManageLinkUsed.
+func (r *MongoRepository) RedeemManageNonce(id, nonce string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	objectId, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("invalid object ID: %w", err)
+	}
+
+	filter := bson.M{"_id": objectId, "manage_nonce": nonce}
+	update := bson.M{"$set": bson.M{"manage_nonce": "", "updated_at": time.Now()}}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to redeem manage link for subscription %s: %w", id, err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrManageLinkUsed
+	}
+	return nil
+}
+
 func (r *MongoRepository) FindByIdAndVerificationCode(id, code string) (*Subscription, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -91,3 +200,24 @@ func (r *MongoRepository) FindByIdAndVerificationCode(id, code string) (*Subscri
 
 	return subscription, nil
 }
+
+// FindDeliverable returns every verified subscription whose delivery is not suspended.
+func (r *MongoRepository) FindDeliverable() ([]*Subscription, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{
+		"verified":        true,
+		"delivery_status": bson.M{"$nin": suspendedStatuses},
+	}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deliverable subscriptions: %w", err)
+	}
+
+	var subscriptions []*Subscription
+	if err := cursor.All(ctx, &subscriptions); err != nil {
+		return nil, fmt.Errorf("failed to decode subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
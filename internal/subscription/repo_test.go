@@ -3,6 +3,7 @@ package subscription
 import (
 	"log"
 	"testing"
+	"time"
 
 	"github.com/Go-roro/wordrop/internal/infra/testhelper"
 	"github.com/stretchr/testify/suite"
@@ -101,3 +102,63 @@ func (suite *SubscriptionRepoTestSuite) TestSubscriptionRepository_FindByIdAndVe
 		suite.NotNil(findOne)
 	})
 }
+
+func (suite *SubscriptionRepoTestSuite) TestSubscriptionRepository_FindById() {
+	suite.Run("Found", func() {
+		sub, _ := suite.repo.SaveSubscription(subscriptionFixture())
+
+		foundSub, err := suite.repo.FindById(sub.ID.Hex())
+		suite.NoError(err, "Expected no error when finding subscription by id")
+		suite.Equal(sub.Email, foundSub.Email)
+	})
+
+	suite.Run("NotFound", func() {
+		_, err := suite.repo.FindById("000000000000000000000000")
+		suite.ErrorIs(err, ErrSubscriptionNotFound)
+	})
+}
+
+func (suite *SubscriptionRepoTestSuite) TestSubscriptionRepository_ClaimDelivery() {
+	suite.Run("Only one run claims a delivery", func() {
+		sub, _ := suite.repo.SaveSubscription(subscriptionFixture())
+		stale := *sub
+		claimedAt := time.Now().Truncate(time.Millisecond)
+
+		suite.NoError(suite.repo.ClaimDelivery(sub, claimedAt), "Expected the first claim to succeed")
+		suite.ErrorIs(suite.repo.ClaimDelivery(&stale, claimedAt.Add(time.Second)), ErrDeliveryClaimed)
+
+		found, err := suite.repo.FindById(sub.ID.Hex())
+		suite.NoError(err)
+		suite.True(claimedAt.Equal(found.LastDeliveredAt), "Expected the first claim to be kept")
+	})
+}
+
+func (suite *SubscriptionRepoTestSuite) TestSubscriptionRepository_UpdateDeliveryState() {
+	suite.Run("Keeps a suspension made during the send", func() {
+		sub, _ := suite.repo.SaveSubscription(subscriptionFixture())
+		sending := *sub
+
+		sub.suspendDelivery(DeliveryComplained, "abuse")
+		suite.NoError(suite.repo.UpdateDeliveryStatus(sub))
+		sending.LastDeliveredAt = time.Now().Truncate(time.Millisecond)
+		sending.DeliveryFailures = 1
+		suite.NoError(suite.repo.UpdateDeliveryState(&sending))
+
+		found, err := suite.repo.FindById(sub.ID.Hex())
+		suite.NoError(err)
+		suite.Equal(DeliveryComplained, found.DeliveryStatus, "Expected the complaint to survive the delivery bookkeeping")
+		suite.Equal(1, found.DeliveryFailures)
+		suite.True(sending.LastDeliveredAt.Equal(found.LastDeliveredAt))
+	})
+}
+
+func (suite *SubscriptionRepoTestSuite) TestSubscriptionRepository_RedeemManageNonce() {
+	suite.Run("A manage link works once", func() {
+		sub := subscriptionFixture()
+		sub.ManageNonce = "nonce"
+		sub, _ = suite.repo.SaveSubscription(sub)
+
+		suite.NoError(suite.repo.RedeemManageNonce(sub.ID.Hex(), "nonce"), "Expected the first use to succeed")
+		suite.ErrorIs(suite.repo.RedeemManageNonce(sub.ID.Hex(), "nonce"), ErrManageLinkUsed)
+	})
+}
@@ -3,19 +3,39 @@ package subscription
 import (
 	"errors"
 	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/Go-roro/wordrop/internal/auth"
+	"github.com/Go-roro/wordrop/internal/word"
 )
 
 type Repository interface {
 	FindByEmail(email string) (*Subscription, error)
+	FindById(id string) (*Subscription, error)
 	SaveSubscription(subscription *Subscription) (*Subscription, error)
 	UpdateSubscription(subscription *Subscription) error
 	FindByIdAndVerificationCode(id string, code string) (*Subscription, error)
+	FindDeliverable() ([]*Subscription, error)
+	ClaimDelivery(subscription *Subscription, claimedAt time.Time) error
+	UpdateDeliveryState(subscription *Subscription) error
+	UpdateDeliveryStatus(subscription *Subscription) error
+	UpdateUsername(subscription *Subscription) error
+	RedeemManageNonce(id, nonce string) error
 }
 
+const (
+	// maxDeliveryAttempts is how many times a delivery is tried before it is given up until the next delivery day.
+	maxDeliveryAttempts = 4
+	// deliveryRetryBackoff is the wait before the first retry of a failed delivery; it doubles with each failure.
+	deliveryRetryBackoff = 15 * time.Minute
+)
+
 type MailSender interface {
 	SendVerificationEmail(email, username, code string) error
+	SendManageLinkEmail(email, username, link string) error
 }
 
 type Service struct {
@@ -64,7 +84,9 @@ func (s *Service) SaveSubscription(saveDto *SaveSubscriptionDto) error {
 }
 
 func (s *Service) sendVerificationEmail(subscription *Subscription) error {
-	subscription.refreshVerificationCode()
+	if err := subscription.refreshVerificationCode(); err != nil {
+		return err
+	}
 	token, err := s.jwtProvider.GenerateVerificationToken(subscription.ID.Hex(), subscription.VerificationCode)
 	if err != nil {
 		return fmt.Errorf("failed to generate verification token: %w", err)
@@ -90,10 +112,280 @@ func (s *Service) VerifySubscription(verificationToken string) error {
 	if err != nil {
 		return fmt.Errorf("failed to find subscription by verification claims: %w", err)
 	}
+	if subscription.DeliveryStatus == DeliveryComplained {
+		return ErrDeliveryComplained
+	}
 
 	subscription.Verified = true
+	subscription.resumeDelivery()
 	if err := s.repository.UpdateSubscription(subscription); err != nil {
 		return fmt.Errorf("failed to update subscription after verification: %w", err)
 	}
 	return nil
 }
+
+// MarkBounced stops deliveries to email after a hard bounce.
+func (s *Service) MarkBounced(email, reason string) error {
+	return s.suspendDelivery(email, DeliveryBounced, reason)
+}
+
+// MarkComplained stops deliveries to email after the recipient reported the mail as spam.
+func (s *Service) MarkComplained(email, reason string) error {
+	return s.suspendDelivery(email, DeliveryComplained, reason)
+}
+
+func (s *Service) suspendDelivery(email string, status DeliveryStatus, reason string) error {
+	subscription, err := s.repository.FindByEmail(email)
+	if err != nil {
+		return fmt.Errorf("failed to find subscription: %w", err)
+	}
+
+	subscription.suspendDelivery(status, reason)
+	if err := s.repository.UpdateDeliveryStatus(subscription); err != nil {
+		return fmt.Errorf("failed to update subscription after %s: %w", status, err)
+	}
+	return nil
+}
+
+// ResumeDelivery lifts a suspension after a bounce or spam complaint. It is an admin action; subscribers
+// can clear a bounce by verifying again, but not a complaint.
+func (s *Service) ResumeDelivery(subscriptionID string) error {
+	subscription, err := s.repository.FindById(subscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to find subscription: %w", err)
+	}
+	if subscription.DeliveryStatus != DeliveryBounced && subscription.DeliveryStatus != DeliveryComplained {
+		return ErrNotSuspended
+	}
+
+	subscription.resumeDelivery()
+	if err := s.repository.UpdateDeliveryStatus(subscription); err != nil {
+		return fmt.Errorf("failed to update subscription after resuming delivery: %w", err)
+	}
+	return nil
+}
+
+// OptOutOfTracking stops open and click tracking for the subscription.
+func (s *Service) OptOutOfTracking(subscriptionID string) error {
+	subscription, err := s.repository.FindById(subscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to find subscription: %w", err)
+	}
+
+	subscription.TrackingOptOut = true
+	if err := s.repository.UpdateSubscription(subscription); err != nil {
+		return fmt.Errorf("failed to update subscription after tracking opt-out: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) IsTrackingOptedOut(subscriptionID string) (bool, error) {
+	subscription, err := s.repository.FindById(subscriptionID)
+	if err != nil {
+		return false, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	return subscription.TrackingOptOut, nil
+}
+
+// PreferencesURL returns the signed self-service link a subscriber edits delivery preferences with.
+func (s *Service) PreferencesURL(subscriptionID string) (string, error) {
+	token, err := s.jwtProvider.GeneratePreferencesToken(subscriptionID)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate preferences token: %w", err)
+	}
+	return fmt.Sprintf("%s/subscriptions/preferences?token=%s", os.Getenv("APP_BASE_URL"), url.QueryEscape(token)), nil
+}
+
+func (s *Service) GetPreferences(preferencesToken string) (*DeliveryPreferences, error) {
+	subscription, err := s.findByPreferencesToken(preferencesToken)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := subscription.Preferences.normalized()
+	return &preferences, nil
+}
+
+func (s *Service) UpdatePreferences(preferencesToken string, updateDto *UpdatePreferencesDto) error {
+	subscription, err := s.findByPreferencesToken(preferencesToken)
+	if err != nil {
+		return err
+	}
+	return s.UpdateSubscriptionPreferences(subscription, updateDto)
+}
+
+func (s *Service) UpdateSubscriptionPreferences(subscription *Subscription, updateDto *UpdatePreferencesDto) error {
+	preferences := DeliveryPreferences{
+		Frequency:    updateDto.Frequency,
+		Days:         updateDto.Days,
+		TimesPerWeek: updateDto.TimesPerWeek,
+		SendTime:     updateDto.SendTime,
+		Timezone:     updateDto.Timezone,
+		PausedFrom:   updateDto.PausedFrom,
+		PausedUntil:  updateDto.PausedUntil,
+		Levels:       updateDto.Levels,
+		Tags:         word.NormalizeTags(updateDto.Tags),
+	}
+	if err := preferences.validate(); err != nil {
+		return err
+	}
+
+	subscription.Preferences = preferences
+	if err := s.repository.UpdateSubscription(subscription); err != nil {
+		return fmt.Errorf("failed to update subscription preferences: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) findByPreferencesToken(preferencesToken string) (*Subscription, error) {
+	claims, err := s.jwtProvider.ParsePreferencesToken(preferencesToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse preferences token: %w", err)
+	}
+
+	subscription, err := s.repository.FindById(claims.SubscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+// FindDueSubscriptions returns the subscriptions whose preferences call for a delivery at now.
+func (s *Service) FindDueSubscriptions(now time.Time) ([]*Subscription, error) {
+	subscriptions, err := s.repository.FindDeliverable()
+	if err != nil {
+		return nil, err
+	}
+
+	var due []*Subscription
+	for _, subscription := range subscriptions {
+		if subscription.IsDueAt(now) {
+			due = append(due, subscription)
+		}
+	}
+	return due, nil
+}
+
+// FindDeliverableSubscriptions returns every verified subscription that has not been suspended or unsubscribed.
+func (s *Service) FindDeliverableSubscriptions() ([]*Subscription, error) {
+	return s.repository.FindDeliverable()
+}
+
+// ClaimDelivery records that a word is about to be sent, before it is sent, so that the subscription is not due
+// again that day however the send and the bookkeeping after it go; only a failed send is retried. It returns
+// ErrDeliveryClaimed when another run claimed the delivery first.
+func (s *Service) ClaimDelivery(subscription *Subscription, claimedAt time.Time) error {
+	if err := s.repository.ClaimDelivery(subscription, claimedAt); err != nil {
+		return err
+	}
+	subscription.LastDeliveredAt = claimedAt
+	subscription.RetryDeliveryAt = time.Time{}
+	return nil
+}
+
+// RecordDelivery records a word sent to the subscription and clears its failed attempts.
+func (s *Service) RecordDelivery(subscription *Subscription, deliveredAt time.Time) error {
+	subscription.LastDeliveredAt = deliveredAt
+	subscription.DeliveryFailures = 0
+	subscription.RetryDeliveryAt = time.Time{}
+	if err := s.repository.UpdateDeliveryState(subscription); err != nil {
+		return fmt.Errorf("failed to record delivery for subscription %s: %w", subscription.ID.Hex(), err)
+	}
+	return nil
+}
+
+// RecordFailedDelivery schedules a retry of a claimed delivery whose send failed, backing off with each failure.
+// After maxDeliveryAttempts the delivery is given up and the subscription waits for its next delivery day.
+func (s *Service) RecordFailedDelivery(subscription *Subscription, failedAt time.Time) error {
+	subscription.DeliveryFailures++
+	subscription.RetryDeliveryAt = time.Time{}
+	if subscription.DeliveryFailures < maxDeliveryAttempts {
+		subscription.RetryDeliveryAt = failedAt.Add(deliveryRetryBackoff << (subscription.DeliveryFailures - 1))
+	} else {
+		subscription.DeliveryFailures = 0
+	}
+	if err := s.repository.UpdateDeliveryState(subscription); err != nil {
+		return fmt.Errorf("failed to record failed delivery for subscription %s: %w", subscription.ID.Hex(), err)
+	}
+	return nil
+}
+
+// SendManageLink emails a single-use "manage my subscription" link to a verified subscriber.
+func (s *Service) SendManageLink(email string) error {
+	subscription, err := s.repository.FindByEmail(email)
+	if err != nil {
+		return fmt.Errorf("failed to find subscription: %w", err)
+	}
+	if !subscription.Verified {
+		return ErrSubscriptionNotFound
+	}
+
+	if err := subscription.refreshManageNonce(); err != nil {
+		return err
+	}
+	token, err := s.jwtProvider.GenerateManageToken(subscription.ID.Hex(), subscription.ManageNonce)
+	if err != nil {
+		return fmt.Errorf("failed to generate manage token: %w", err)
+	}
+	if err := s.repository.UpdateSubscription(subscription); err != nil {
+		return fmt.Errorf("failed to update subscription before sending manage link: %w", err)
+	}
+
+	link := fmt.Sprintf("%s/manage/login?token=%s", os.Getenv("APP_BASE_URL"), url.QueryEscape(token))
+	if err := s.mailSender.SendManageLinkEmail(subscription.Email, subscription.Username, link); err != nil {
+		return fmt.Errorf("failed to send manage link email: %w", err)
+	}
+	return nil
+}
+
+// RedeemManageLink uses up a manage link and returns a short-lived session token for the manage pages.
+func (s *Service) RedeemManageLink(manageToken string) (string, error) {
+	claims, err := s.jwtProvider.ParseManageToken(manageToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse manage token: %w", err)
+	}
+
+	if claims.ID == "" {
+		return "", ErrManageLinkUsed
+	}
+	if err := s.repository.RedeemManageNonce(claims.SubscriptionID, claims.ID); err != nil {
+		return "", err
+	}
+
+	return s.jwtProvider.GenerateManageSessionToken(claims.SubscriptionID)
+}
+
+func (s *Service) FindManagedSubscription(sessionToken string) (*Subscription, error) {
+	claims, err := s.jwtProvider.ParseManageSessionToken(sessionToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse manage session token: %w", err)
+	}
+
+	subscription, err := s.repository.FindById(claims.SubscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+func (s *Service) UpdateProfile(subscription *Subscription, updateDto *UpdateProfileDto) error {
+	username := strings.TrimSpace(updateDto.Username)
+	if username == "" {
+		return ErrInvalidProfile
+	}
+
+	subscription.Username = username
+	if err := s.repository.UpdateUsername(subscription); err != nil {
+		return fmt.Errorf("failed to update subscription profile: %w", err)
+	}
+	return nil
+}
+
+// Unsubscribe stops all deliveries until the subscriber signs up and verifies again.
+func (s *Service) Unsubscribe(subscription *Subscription) error {
+	subscription.suspendDelivery(DeliveryUnsubscribed, "unsubscribed by subscriber")
+	if err := s.repository.UpdateDeliveryStatus(subscription); err != nil {
+		return fmt.Errorf("failed to update subscription after unsubscribing: %w", err)
+	}
+	return nil
+}
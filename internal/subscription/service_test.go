@@ -1,6 +1,7 @@
 package subscription
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -191,3 +192,272 @@ func (suite *SubscriptionServiceTestSuite) TestVerifySubscription_Success() {
 	suite.NoError(err)
 	suite.True(sub.Verified, "Expected subscription to be verified")
 }
+
+func (suite *SubscriptionServiceTestSuite) TestMarkBounced_Success() {
+	// Given
+	sub := NewSubscription("user", "gone@example.com")
+	sub.Verified = true
+	suite.mockRepo.EXPECT().FindByEmail(sub.Email).Return(sub, nil)
+	suite.mockRepo.EXPECT().UpdateDeliveryStatus(sub).Return(nil)
+
+	// When
+	err := suite.service.MarkBounced(sub.Email, "550 5.1.1 user unknown")
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(DeliveryBounced, sub.DeliveryStatus)
+	suite.Equal("550 5.1.1 user unknown", sub.SuspensionReason)
+	suite.False(sub.IsDeliverable(), "Expected bounced subscription to stop receiving deliveries")
+}
+
+func (suite *SubscriptionServiceTestSuite) TestMarkComplained_NotFound() {
+	// Given
+	suite.mockRepo.EXPECT().FindByEmail("stranger@example.com").Return(nil, ErrSubscriptionNotFound)
+
+	// When
+	err := suite.service.MarkComplained("stranger@example.com", "abuse")
+
+	// Then
+	suite.ErrorIs(err, ErrSubscriptionNotFound)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateDeliveryStatus")
+}
+
+func (suite *SubscriptionServiceTestSuite) TestVerifySubscription_ResumesSuspendedDelivery() {
+	// Given
+	sub := NewSubscription("user", "user@email.com")
+	sub.ID = primitive.NewObjectID()
+	sub.VerificationCode = "code123"
+	sub.suspendDelivery(DeliveryBounced, "550 user unknown")
+	suite.mockRepo.EXPECT().FindByIdAndVerificationCode(sub.ID.Hex(), sub.VerificationCode).Return(sub, nil)
+	suite.mockRepo.EXPECT().UpdateSubscription(sub).Return(nil)
+
+	verificationToken, err := suite.provider.GenerateVerificationToken(sub.ID.Hex(), sub.VerificationCode)
+	suite.NoError(err)
+
+	// When
+	err = suite.service.VerifySubscription(verificationToken)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(DeliveryActive, sub.DeliveryStatus)
+	suite.True(sub.IsDeliverable())
+}
+
+func (suite *SubscriptionServiceTestSuite) TestVerifySubscription_KeepsComplaintSuspension() {
+	// Given
+	sub := NewSubscription("user", "user@email.com")
+	sub.ID = primitive.NewObjectID()
+	sub.Verified = true
+	sub.VerificationCode = "code123"
+	sub.suspendDelivery(DeliveryComplained, "abuse")
+	suite.mockRepo.EXPECT().FindByIdAndVerificationCode(sub.ID.Hex(), sub.VerificationCode).Return(sub, nil)
+
+	verificationToken, err := suite.provider.GenerateVerificationToken(sub.ID.Hex(), sub.VerificationCode)
+	suite.NoError(err)
+
+	// When
+	err = suite.service.VerifySubscription(verificationToken)
+
+	// Then
+	suite.ErrorIs(err, ErrDeliveryComplained)
+	suite.Equal(DeliveryComplained, sub.DeliveryStatus)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateSubscription", mock.Anything)
+}
+
+func (suite *SubscriptionServiceTestSuite) TestResumeDelivery() {
+	suite.Run("Complained", func() {
+		sub := &Subscription{ID: primitive.NewObjectID(), Verified: true}
+		sub.suspendDelivery(DeliveryComplained, "abuse")
+		suite.mockRepo.EXPECT().FindById(sub.ID.Hex()).Return(sub, nil).Once()
+		suite.mockRepo.EXPECT().UpdateDeliveryStatus(sub).Return(nil).Once()
+
+		suite.NoError(suite.service.ResumeDelivery(sub.ID.Hex()))
+		suite.Equal(DeliveryActive, sub.DeliveryStatus)
+		suite.True(sub.IsDeliverable())
+	})
+
+	suite.Run("Unsubscribed", func() {
+		sub := &Subscription{ID: primitive.NewObjectID(), Verified: true}
+		sub.suspendDelivery(DeliveryUnsubscribed, "unsubscribed by subscriber")
+		suite.mockRepo.EXPECT().FindById(sub.ID.Hex()).Return(sub, nil).Once()
+
+		suite.ErrorIs(suite.service.ResumeDelivery(sub.ID.Hex()), ErrNotSuspended)
+		suite.Equal(DeliveryUnsubscribed, sub.DeliveryStatus)
+	})
+}
+
+func (suite *SubscriptionServiceTestSuite) TestOptOutOfTracking_Success() {
+	// Given
+	sub := NewSubscription("user", "user@email.com")
+	sub.ID = primitive.NewObjectID()
+	suite.mockRepo.EXPECT().FindById(sub.ID.Hex()).Return(sub, nil)
+	suite.mockRepo.EXPECT().UpdateSubscription(sub).Return(nil)
+
+	// When
+	err := suite.service.OptOutOfTracking(sub.ID.Hex())
+
+	// Then
+	suite.NoError(err)
+	suite.True(sub.TrackingOptOut, "Expected subscription to opt out of tracking")
+}
+
+func (suite *SubscriptionServiceTestSuite) TestUpdatePreferences_Success() {
+	// Given
+	sub := &Subscription{ID: primitive.NewObjectID(), Verified: true}
+	token, err := suite.provider.GeneratePreferencesToken(sub.ID.Hex())
+	suite.Require().NoError(err)
+
+	updateDto := &UpdatePreferencesDto{
+		Frequency:   FrequencyWeekdays,
+		SendTime:    "07:00",
+		Timezone:    "Europe/London",
+		PausedFrom:  "2025-12-24",
+		PausedUntil: "2026-01-02",
+	}
+	suite.mockRepo.EXPECT().FindById(sub.ID.Hex()).Return(sub, nil)
+	suite.mockRepo.EXPECT().UpdateSubscription(mock.MatchedBy(func(s *Subscription) bool {
+		return s.Preferences.Frequency == FrequencyWeekdays && s.Preferences.Timezone == "Europe/London" && s.Preferences.PausedUntil == "2026-01-02"
+	})).Return(nil)
+
+	// When
+	err = suite.service.UpdatePreferences(token, updateDto)
+
+	// Then
+	suite.NoError(err)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *SubscriptionServiceTestSuite) TestUpdatePreferences_Invalid() {
+	// Given
+	sub := &Subscription{ID: primitive.NewObjectID(), Verified: true}
+	token, err := suite.provider.GeneratePreferencesToken(sub.ID.Hex())
+	suite.Require().NoError(err)
+	suite.mockRepo.EXPECT().FindById(sub.ID.Hex()).Return(sub, nil)
+
+	// When
+	err = suite.service.UpdatePreferences(token, &UpdatePreferencesDto{Frequency: FrequencyDaily, SendTime: "8am", Timezone: "Asia/Seoul"})
+
+	// Then
+	suite.ErrorIs(err, ErrInvalidPreferences)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateSubscription", mock.Anything)
+}
+
+func (suite *SubscriptionServiceTestSuite) TestUpdatePreferences_InvalidToken() {
+	// When
+	err := suite.service.UpdatePreferences("not-a-token", &UpdatePreferencesDto{})
+
+	// Then
+	suite.Error(err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "FindById", mock.Anything)
+}
+
+func (suite *SubscriptionServiceTestSuite) TestFindDueSubscriptions() {
+	// Given
+	now := time.Date(2025, 8, 6, 12, 0, 0, 0, time.UTC) // 21:00 in Seoul, past the default send time
+	due := &Subscription{ID: primitive.NewObjectID(), Verified: true}
+	deliveredToday := &Subscription{ID: primitive.NewObjectID(), Verified: true, LastDeliveredAt: now}
+	suite.mockRepo.EXPECT().FindDeliverable().Return([]*Subscription{due, deliveredToday}, nil)
+
+	// When
+	result, err := suite.service.FindDueSubscriptions(now)
+
+	// Then
+	suite.NoError(err)
+	suite.Len(result, 1)
+}
+
+func (suite *SubscriptionServiceTestSuite) TestRecordFailedDelivery_BacksOffThenGivesUp() {
+	// Given
+	now := time.Now()
+	subscription := &Subscription{ID: primitive.NewObjectID(), Verified: true, LastDeliveredAt: now}
+	suite.mockRepo.EXPECT().UpdateDeliveryState(subscription).Return(nil)
+
+	// When
+	var retries []time.Duration
+	for attempt := 1; attempt < maxDeliveryAttempts; attempt++ {
+		suite.NoError(suite.service.RecordFailedDelivery(subscription, now))
+		retries = append(retries, subscription.RetryDeliveryAt.Sub(now))
+	}
+	suite.NoError(suite.service.RecordFailedDelivery(subscription, now))
+
+	// Then
+	suite.Equal([]time.Duration{15 * time.Minute, 30 * time.Minute, time.Hour}, retries)
+	suite.Zero(subscription.RetryDeliveryAt, "Expected no retry after the last attempt")
+	suite.Zero(subscription.DeliveryFailures, "Expected the next delivery day to start over")
+	suite.False(subscription.IsDueAt(now), "Expected a given up delivery to wait for the next delivery day")
+}
+
+func (suite *SubscriptionServiceTestSuite) TestSendManageLink_Success() {
+	// Given
+	sub := &Subscription{ID: primitive.NewObjectID(), Email: "user@example.com", Username: "tester", Verified: true}
+	suite.mockRepo.EXPECT().FindByEmail(sub.Email).Return(sub, nil)
+	suite.mockRepo.EXPECT().UpdateSubscription(mock.MatchedBy(func(s *Subscription) bool {
+		return s.ManageNonce != ""
+	})).Return(nil)
+	suite.mockMailSender.EXPECT().SendManageLinkEmail(sub.Email, sub.Username, mock.MatchedBy(func(link string) bool {
+		return strings.Contains(link, "/manage/login?token=")
+	})).Return(nil)
+
+	// When
+	err := suite.service.SendManageLink(sub.Email)
+
+	// Then
+	suite.NoError(err)
+	suite.mockMailSender.AssertExpectations(suite.T())
+}
+
+func (suite *SubscriptionServiceTestSuite) TestSendManageLink_NotVerified() {
+	// Given
+	sub := &Subscription{ID: primitive.NewObjectID(), Email: "user@example.com", Verified: false}
+	suite.mockRepo.EXPECT().FindByEmail(sub.Email).Return(sub, nil)
+
+	// When
+	err := suite.service.SendManageLink(sub.Email)
+
+	// Then
+	suite.ErrorIs(err, ErrSubscriptionNotFound)
+	suite.mockMailSender.AssertNotCalled(suite.T(), "SendManageLinkEmail", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *SubscriptionServiceTestSuite) TestRedeemManageLink_SingleUse() {
+	// Given
+	sub := &Subscription{ID: primitive.NewObjectID(), Verified: true, ManageNonce: "nonce"}
+	token, err := suite.provider.GenerateManageToken(sub.ID.Hex(), "nonce")
+	suite.Require().NoError(err)
+	suite.mockRepo.EXPECT().RedeemManageNonce(sub.ID.Hex(), "nonce").Return(nil).Once()
+	suite.mockRepo.EXPECT().RedeemManageNonce(sub.ID.Hex(), "nonce").Return(ErrManageLinkUsed).Once()
+
+	// When
+	sessionToken, err := suite.service.RedeemManageLink(token)
+	_, reuseErr := suite.service.RedeemManageLink(token)
+
+	// Then
+	suite.NoError(err)
+	claims, err := suite.provider.ParseManageSessionToken(sessionToken)
+	suite.NoError(err)
+	suite.Equal(sub.ID.Hex(), claims.SubscriptionID)
+	suite.ErrorIs(reuseErr, ErrManageLinkUsed)
+}
+
+func (suite *SubscriptionServiceTestSuite) TestUnsubscribe_StopsDelivery() {
+	// Given
+	sub := &Subscription{ID: primitive.NewObjectID(), Verified: true, DeliveryStatus: DeliveryActive}
+	suite.mockRepo.EXPECT().UpdateDeliveryStatus(sub).Return(nil)
+
+	// When
+	err := suite.service.Unsubscribe(sub)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(DeliveryUnsubscribed, sub.DeliveryStatus)
+	suite.False(sub.IsDeliverable())
+}
+
+func (suite *SubscriptionServiceTestSuite) TestUpdateProfile_EmptyUsername() {
+	// When
+	err := suite.service.UpdateProfile(&Subscription{Username: "tester"}, &UpdateProfileDto{Username: "  "})
+
+	// Then
+	suite.ErrorIs(err, ErrInvalidProfile)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateUsername", mock.Anything)
+}
@@ -0,0 +1,298 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package tracking
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// SaveEvent provides a mock function for the type MockRepository
+func (_mock *MockRepository) SaveEvent(event *Event) (*Event, error) {
+	ret := _mock.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveEvent")
+	}
+
+	var r0 *Event
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*Event) (*Event, error)); ok {
+		return returnFunc(event)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*Event) *Event); ok {
+		r0 = returnFunc(event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Event)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*Event) error); ok {
+		r1 = returnFunc(event)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_SaveEvent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveEvent'
+type MockRepository_SaveEvent_Call struct {
+	*mock.Call
+}
+
+// SaveEvent is a helper method to define mock.On call
+//   - event *Event
+func (_e *MockRepository_Expecter) SaveEvent(event interface{}) *MockRepository_SaveEvent_Call {
+	return &MockRepository_SaveEvent_Call{Call: _e.mock.On("SaveEvent", event)}
+}
+
+func (_c *MockRepository_SaveEvent_Call) Run(run func(event *Event)) *MockRepository_SaveEvent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Event
+		if args[0] != nil {
+			arg0 = args[0].(*Event)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveEvent_Call) Return(event1 *Event, err error) *MockRepository_SaveEvent_Call {
+	_c.Call.Return(event1, err)
+	return _c
+}
+
+func (_c *MockRepository_SaveEvent_Call) RunAndReturn(run func(event *Event) (*Event, error)) *MockRepository_SaveEvent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Summarize provides a mock function for the type MockRepository
+func (_mock *MockRepository) Summarize(filter *SummaryFilter) (*Summary, error) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Summarize")
+	}
+
+	var r0 *Summary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*SummaryFilter) (*Summary, error)); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*SummaryFilter) *Summary); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Summary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*SummaryFilter) error); ok {
+		r1 = returnFunc(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_Summarize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summarize'
+type MockRepository_Summarize_Call struct {
+	*mock.Call
+}
+
+// Summarize is a helper method to define mock.On call
+//   - filter *SummaryFilter
+func (_e *MockRepository_Expecter) Summarize(filter interface{}) *MockRepository_Summarize_Call {
+	return &MockRepository_Summarize_Call{Call: _e.mock.On("Summarize", filter)}
+}
+
+func (_c *MockRepository_Summarize_Call) Run(run func(filter *SummaryFilter)) *MockRepository_Summarize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *SummaryFilter
+		if args[0] != nil {
+			arg0 = args[0].(*SummaryFilter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_Summarize_Call) Return(summary *Summary, err error) *MockRepository_Summarize_Call {
+	_c.Call.Return(summary, err)
+	return _c
+}
+
+func (_c *MockRepository_Summarize_Call) RunAndReturn(run func(filter *SummaryFilter) (*Summary, error)) *MockRepository_Summarize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSubscriptions creates a new instance of MockSubscriptions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSubscriptions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSubscriptions {
+	mock := &MockSubscriptions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockSubscriptions is an autogenerated mock type for the Subscriptions type
+type MockSubscriptions struct {
+	mock.Mock
+}
+
+type MockSubscriptions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSubscriptions) EXPECT() *MockSubscriptions_Expecter {
+	return &MockSubscriptions_Expecter{mock: &_m.Mock}
+}
+
+// IsTrackingOptedOut provides a mock function for the type MockSubscriptions
+func (_mock *MockSubscriptions) IsTrackingOptedOut(subscriptionID string) (bool, error) {
+	ret := _mock.Called(subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsTrackingOptedOut")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return returnFunc(subscriptionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(subscriptionID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(subscriptionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSubscriptions_IsTrackingOptedOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsTrackingOptedOut'
+type MockSubscriptions_IsTrackingOptedOut_Call struct {
+	*mock.Call
+}
+
+// IsTrackingOptedOut is a helper method to define mock.On call
+//   - subscriptionID string
+func (_e *MockSubscriptions_Expecter) IsTrackingOptedOut(subscriptionID interface{}) *MockSubscriptions_IsTrackingOptedOut_Call {
+	return &MockSubscriptions_IsTrackingOptedOut_Call{Call: _e.mock.On("IsTrackingOptedOut", subscriptionID)}
+}
+
+func (_c *MockSubscriptions_IsTrackingOptedOut_Call) Run(run func(subscriptionID string)) *MockSubscriptions_IsTrackingOptedOut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptions_IsTrackingOptedOut_Call) Return(b bool, err error) *MockSubscriptions_IsTrackingOptedOut_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSubscriptions_IsTrackingOptedOut_Call) RunAndReturn(run func(subscriptionID string) (bool, error)) *MockSubscriptions_IsTrackingOptedOut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OptOutOfTracking provides a mock function for the type MockSubscriptions
+func (_mock *MockSubscriptions) OptOutOfTracking(subscriptionID string) error {
+	ret := _mock.Called(subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OptOutOfTracking")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(subscriptionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSubscriptions_OptOutOfTracking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OptOutOfTracking'
+type MockSubscriptions_OptOutOfTracking_Call struct {
+	*mock.Call
+}
+
+// OptOutOfTracking is a helper method to define mock.On call
+//   - subscriptionID string
+func (_e *MockSubscriptions_Expecter) OptOutOfTracking(subscriptionID interface{}) *MockSubscriptions_OptOutOfTracking_Call {
+	return &MockSubscriptions_OptOutOfTracking_Call{Call: _e.mock.On("OptOutOfTracking", subscriptionID)}
+}
+
+func (_c *MockSubscriptions_OptOutOfTracking_Call) Run(run func(subscriptionID string)) *MockSubscriptions_OptOutOfTracking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSubscriptions_OptOutOfTracking_Call) Return(err error) *MockSubscriptions_OptOutOfTracking_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSubscriptions_OptOutOfTracking_Call) RunAndReturn(run func(subscriptionID string) error) *MockSubscriptions_OptOutOfTracking_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,36 @@
+package tracking
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type EventType string
+
+const (
+	EventOpen  EventType = "open"
+	EventClick EventType = "click"
+)
+
+type Event struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	SubscriptionID primitive.ObjectID `bson:"subscription_id"`
+	WordID         primitive.ObjectID `bson:"word_id"`
+	Type           EventType          `bson:"type"`
+	URL            string             `bson:"url,omitempty"`
+	CreatedAt      time.Time          `bson:"created_at"`
+}
+
+// SummaryFilter narrows a summary to one subscription, one word, or both.
+type SummaryFilter struct {
+	SubscriptionID string
+	WordID         string
+}
+
+type Summary struct {
+	Opens        int64 `json:"opens"`
+	UniqueOpens  int   `json:"unique_opens"`
+	Clicks       int64 `json:"clicks"`
+	UniqueClicks int   `json:"unique_clicks"`
+}
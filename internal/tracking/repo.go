@@ -0,0 +1,91 @@
+package tracking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const collectionName = "tracking_events"
+
+type MongoRepository struct {
+	collection *mongo.Collection
+}
+
+func NewTrackingRepo(db *mongo.Database) *MongoRepository {
+	return &MongoRepository{
+		collection: db.Collection(collectionName),
+	}
+}
+
+func (r *MongoRepository) SaveEvent(event *Event) (*Event, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	event.CreatedAt = time.Now()
+	result, err := r.collection.InsertOne(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	event.ID = result.InsertedID.(primitive.ObjectID)
+	return event, nil
+}
+
+func (r *MongoRepository) Summarize(filter *SummaryFilter) (*Summary, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	base, err := setupFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{}
+	for _, eventType := range []EventType{EventOpen, EventClick} {
+		typeFilter := bson.M{"type": eventType}
+		for key, value := range base {
+			typeFilter[key] = value
+		}
+
+		count, err := r.collection.CountDocuments(ctx, typeFilter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count %s events: %w", eventType, err)
+		}
+
+		subscribers, err := r.collection.Distinct(ctx, "subscription_id", typeFilter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count unique %s events: %w", eventType, err)
+		}
+
+		if eventType == EventOpen {
+			summary.Opens, summary.UniqueOpens = count, len(subscribers)
+		} else {
+			summary.Clicks, summary.UniqueClicks = count, len(subscribers)
+		}
+	}
+	return summary, nil
+}
+
+func setupFilter(filter *SummaryFilter) (bson.M, error) {
+	result := bson.M{}
+	if filter.SubscriptionID != "" {
+		subscriptionID, err := primitive.ObjectIDFromHex(filter.SubscriptionID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subscription ID: %w", err)
+		}
+		result["subscription_id"] = subscriptionID
+	}
+	if filter.WordID != "" {
+		wordID, err := primitive.ObjectIDFromHex(filter.WordID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid word ID: %w", err)
+		}
+		result["word_id"] = wordID
+	}
+	return result, nil
+}
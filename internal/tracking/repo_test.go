@@ -0,0 +1,64 @@
+package tracking
+
+import (
+	"log"
+	"testing"
+
+	"github.com/Go-roro/wordrop/internal/infra/testhelper"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type TrackingRepoTestSuite struct {
+	suite.Suite
+	database *testhelper.TestDatabase
+	repo     *MongoRepository
+}
+
+func (suite *TrackingRepoTestSuite) SetupSuite() {
+	log.Println("Setting up TrackingRepoTestSuite...")
+	suite.database = testhelper.SetupTestDatabase()
+	suite.repo = NewTrackingRepo(suite.database.DbInstance)
+}
+
+func (suite *TrackingRepoTestSuite) TearDownSuite() {
+	log.Println("Tearing down TrackingRepoTestSuite...")
+	suite.database.TearDown()
+}
+
+func (suite *TrackingRepoTestSuite) BeforeTest(suiteName, testName string) {
+	log.Printf("Before test: %s - %s\n", suiteName, testName)
+	if err := suite.database.CleanUp(); err != nil {
+		log.Fatalf("Failed to clean up database before test: %v", err)
+	}
+}
+
+func TestTrackingRepoTestSuite(t *testing.T) {
+	suite.Run(t, new(TrackingRepoTestSuite))
+}
+
+func (suite *TrackingRepoTestSuite) TestTrackingRepository_Summarize() {
+	suite.Run("Summarize by word", func() {
+		wordID := primitive.NewObjectID()
+		subscriberA := primitive.NewObjectID()
+		subscriberB := primitive.NewObjectID()
+		for _, event := range []*Event{
+			{SubscriptionID: subscriberA, WordID: wordID, Type: EventOpen},
+			{SubscriptionID: subscriberA, WordID: wordID, Type: EventOpen},
+			{SubscriptionID: subscriberB, WordID: wordID, Type: EventOpen},
+			{SubscriptionID: subscriberB, WordID: wordID, Type: EventClick, URL: "https://example.com"},
+			{SubscriptionID: subscriberB, WordID: primitive.NewObjectID(), Type: EventOpen},
+		} {
+			_, err := suite.repo.SaveEvent(event)
+			suite.NoError(err, "Expected no error when saving event")
+		}
+
+		summary, err := suite.repo.Summarize(&SummaryFilter{WordID: wordID.Hex()})
+		suite.NoError(err, "Expected no error when summarizing events")
+
+		suite.Equal(int64(3), summary.Opens)
+		suite.Equal(2, summary.UniqueOpens)
+		suite.Equal(int64(1), summary.Clicks)
+		suite.Equal(1, summary.UniqueClicks)
+	})
+}
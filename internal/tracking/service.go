@@ -0,0 +1,119 @@
+package tracking
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+
+	"github.com/Go-roro/wordrop/internal/auth"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type Repository interface {
+	SaveEvent(event *Event) (*Event, error)
+	Summarize(filter *SummaryFilter) (*Summary, error)
+}
+
+// Subscriptions is the part of the subscription service tracking relies on to honour opt-outs.
+type Subscriptions interface {
+	IsTrackingOptedOut(subscriptionID string) (bool, error)
+	OptOutOfTracking(subscriptionID string) error
+}
+
+type Service struct {
+	repository    Repository
+	subscriptions Subscriptions
+	jwtProvider   *auth.JwtProvider
+}
+
+func NewTrackingService(repo Repository, subscriptions Subscriptions, provider *auth.JwtProvider) *Service {
+	return &Service{
+		repository:    repo,
+		subscriptions: subscriptions,
+		jwtProvider:   provider,
+	}
+}
+
+func (s *Service) OpenPixelURL(subscriptionID, wordID string) (string, error) {
+	return s.trackingURL("open", subscriptionID, wordID, "")
+}
+
+func (s *Service) ClickURL(subscriptionID, wordID, target string) (string, error) {
+	return s.trackingURL("click", subscriptionID, wordID, target)
+}
+
+func (s *Service) OptOutURL(subscriptionID string) (string, error) {
+	return s.trackingURL("opt-out", subscriptionID, "", "")
+}
+
+func (s *Service) trackingURL(action, subscriptionID, wordID, target string) (string, error) {
+	token, err := s.jwtProvider.GenerateTrackingToken(subscriptionID, wordID, target)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate tracking token: %w", err)
+	}
+	return fmt.Sprintf("%s/tracking/%s/%s", os.Getenv("APP_BASE_URL"), action, url.PathEscape(token)), nil
+}
+
+func (s *Service) RecordOpen(token string) error {
+	_, err := s.record(token, EventOpen)
+	return err
+}
+
+// RecordClick records the click and returns the redirect target. The target is returned
+// even when recording fails so that the subscriber still reaches the link.
+func (s *Service) RecordClick(token string) (string, error) {
+	claims, err := s.record(token, EventClick)
+	if claims == nil {
+		return "", err
+	}
+	return claims.URL, err
+}
+
+func (s *Service) OptOut(token string) error {
+	claims, err := s.jwtProvider.ParseTrackingToken(token)
+	if err != nil {
+		return fmt.Errorf("failed to parse tracking token: %w", err)
+	}
+	return s.subscriptions.OptOutOfTracking(claims.SubscriptionID)
+}
+
+func (s *Service) Summarize(filter *SummaryFilter) (*Summary, error) {
+	return s.repository.Summarize(filter)
+}
+
+func (s *Service) record(token string, eventType EventType) (*auth.TrackingTokenClaims, error) {
+	claims, err := s.jwtProvider.ParseTrackingToken(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tracking token: %w", err)
+	}
+
+	optedOut, err := s.subscriptions.IsTrackingOptedOut(claims.SubscriptionID)
+	if err != nil {
+		return claims, err
+	}
+	if optedOut {
+		log.Printf("Skipping %s event for subscription %s that opted out of tracking", eventType, claims.SubscriptionID)
+		return claims, nil
+	}
+
+	subscriptionID, err := primitive.ObjectIDFromHex(claims.SubscriptionID)
+	if err != nil {
+		return claims, fmt.Errorf("invalid subscription ID: %w", err)
+	}
+	wordID, err := primitive.ObjectIDFromHex(claims.WordID)
+	if err != nil {
+		return claims, fmt.Errorf("invalid word ID: %w", err)
+	}
+
+	event := &Event{
+		SubscriptionID: subscriptionID,
+		WordID:         wordID,
+		Type:           eventType,
+		URL:            claims.URL,
+	}
+	if _, err := s.repository.SaveEvent(event); err != nil {
+		return claims, fmt.Errorf("failed to save %s event: %w", eventType, err)
+	}
+	return claims, nil
+}
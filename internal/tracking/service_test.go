@@ -0,0 +1,122 @@
+package tracking
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/Go-roro/wordrop/internal/auth"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type TrackingServiceTestSuite struct {
+	suite.Suite
+	mockRepo          *MockRepository
+	mockSubscriptions *MockSubscriptions
+	provider          *auth.JwtProvider
+	service           *Service
+}
+
+func (suite *TrackingServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockRepository)
+	suite.mockSubscriptions = new(MockSubscriptions)
+	provider, _ := auth.NewJwtProvider("a-string-secret-at-least-256-bits-long")
+	suite.provider = provider
+	suite.service = NewTrackingService(suite.mockRepo, suite.mockSubscriptions, provider)
+}
+
+func TestTrackingServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(TrackingServiceTestSuite))
+}
+
+func (suite *TrackingServiceTestSuite) tokenFromURL(link string) string {
+	parsed, err := url.Parse(link)
+	suite.Require().NoError(err)
+	segments := strings.Split(parsed.Path, "/")
+	return segments[len(segments)-1]
+}
+
+func (suite *TrackingServiceTestSuite) TestRecordOpen_Success() {
+	// Given
+	subscriptionID := primitive.NewObjectID()
+	wordID := primitive.NewObjectID()
+	pixelURL, err := suite.service.OpenPixelURL(subscriptionID.Hex(), wordID.Hex())
+	suite.Require().NoError(err)
+
+	suite.mockSubscriptions.EXPECT().IsTrackingOptedOut(subscriptionID.Hex()).Return(false, nil)
+	suite.mockRepo.EXPECT().SaveEvent(mock.MatchedBy(func(event *Event) bool {
+		return event.Type == EventOpen && event.SubscriptionID == subscriptionID && event.WordID == wordID
+	})).Return(&Event{}, nil)
+
+	// When
+	err = suite.service.RecordOpen(suite.tokenFromURL(pixelURL))
+
+	// Then
+	suite.NoError(err)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *TrackingServiceTestSuite) TestRecordClick_ReturnsTarget() {
+	// Given
+	subscriptionID := primitive.NewObjectID()
+	wordID := primitive.NewObjectID()
+	target := "https://en.dict.naver.com/#/search?query=serendipity"
+	clickURL, err := suite.service.ClickURL(subscriptionID.Hex(), wordID.Hex(), target)
+	suite.Require().NoError(err)
+
+	suite.mockSubscriptions.EXPECT().IsTrackingOptedOut(subscriptionID.Hex()).Return(false, nil)
+	suite.mockRepo.EXPECT().SaveEvent(mock.MatchedBy(func(event *Event) bool {
+		return event.Type == EventClick && event.URL == target
+	})).Return(&Event{}, nil)
+
+	// When
+	redirect, err := suite.service.RecordClick(suite.tokenFromURL(clickURL))
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(target, redirect)
+}
+
+func (suite *TrackingServiceTestSuite) TestRecordClick_OptedOutSubscriberIsNotRecorded() {
+	// Given
+	subscriptionID := primitive.NewObjectID()
+	target := "https://example.com"
+	clickURL, err := suite.service.ClickURL(subscriptionID.Hex(), primitive.NewObjectID().Hex(), target)
+	suite.Require().NoError(err)
+
+	suite.mockSubscriptions.EXPECT().IsTrackingOptedOut(subscriptionID.Hex()).Return(true, nil)
+
+	// When
+	redirect, err := suite.service.RecordClick(suite.tokenFromURL(clickURL))
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(target, redirect)
+	suite.mockRepo.AssertNotCalled(suite.T(), "SaveEvent")
+}
+
+func (suite *TrackingServiceTestSuite) TestRecordClick_InvalidToken() {
+	// When
+	redirect, err := suite.service.RecordClick("not.a.token")
+
+	// Then
+	suite.Error(err)
+	suite.Empty(redirect, "Expected no redirect target for a forged link")
+}
+
+func (suite *TrackingServiceTestSuite) TestOptOut_Success() {
+	// Given
+	subscriptionID := primitive.NewObjectID().Hex()
+	optOutURL, err := suite.service.OptOutURL(subscriptionID)
+	suite.Require().NoError(err)
+	suite.mockSubscriptions.EXPECT().OptOutOfTracking(subscriptionID).Return(nil)
+
+	// When
+	err = suite.service.OptOut(suite.tokenFromURL(optOutURL))
+
+	// Then
+	suite.NoError(err)
+	suite.mockSubscriptions.AssertExpectations(suite.T())
+}
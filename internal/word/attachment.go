@@ -0,0 +1,36 @@
+package word
+
+import (
+	"slices"
+	"time"
+)
+
+// MediaKind is what an attachment holds.
+type MediaKind string
+
+const (
+	MediaImage MediaKind = "image"
+	MediaAudio MediaKind = "audio"
+)
+
+// Attachment is a file uploaded for a word, such as a picture or a pronunciation recording.
+// The file itself is kept in media storage under Key and is only handed out through signed URLs.
+type Attachment struct {
+	ID          string    `bson:"id" json:"id"`
+	Kind        MediaKind `bson:"kind" json:"kind"`
+	Key         string    `bson:"key" json:"-"`
+	ContentType string    `bson:"content_type" json:"content_type"`
+	Size        int64     `bson:"size" json:"size"`
+	Filename    string    `bson:"filename" json:"filename"`
+	UploadedBy  string    `bson:"uploaded_by" json:"uploaded_by,omitempty"`
+	UploadedAt  time.Time `bson:"uploaded_at" json:"uploaded_at"`
+}
+
+// Attachment returns the attachment of the word with the given ID.
+func (w *Word) Attachment(id string) (Attachment, bool) {
+	index := slices.IndexFunc(w.Attachments, func(attachment Attachment) bool { return attachment.ID == id })
+	if index < 0 {
+		return Attachment{}, false
+	}
+	return w.Attachments[index], true
+}
@@ -0,0 +1,91 @@
+package word
+
+import (
+	"slices"
+	"strings"
+)
+
+// Content is the part of a word editors write, as recorded in its revisions.
+type Content struct {
+	Text           string         `bson:"text" json:"word"`
+	EnglishMeaning string         `bson:"english_meaning" json:"english_meaning"`
+	KoreanMeanings []string       `bson:"korean_meaning" json:"korean_meaning"`
+	PartsOfSpeech  []PartOfSpeech `bson:"parts_of_speech" json:"part_of_speech"`
+	IPA            string         `bson:"ipa" json:"ipa"`
+	AudioURL       string         `bson:"audio_url" json:"audio_url"`
+	Description    string         `bson:"description" json:"description"`
+	Examples       []Example      `bson:"examples" json:"examples"`
+	Synonyms       []string       `bson:"synonyms" json:"synonyms"`
+	Level          Level          `bson:"level" json:"level"`
+	Tags           []string       `bson:"tags" json:"tags"`
+	Senses         []Sense        `bson:"senses" json:"senses"`
+}
+
+func (w *Word) content() Content {
+	return Content{
+		Text:           w.Text,
+		EnglishMeaning: w.EnglishMeaning,
+		KoreanMeanings: slices.Clone(w.KoreanMeanings),
+		PartsOfSpeech:  slices.Clone(w.PartsOfSpeech),
+		IPA:            w.IPA,
+		AudioURL:       w.AudioURL,
+		Description:    w.Description,
+		Examples:       slices.Clone(w.Examples),
+		Synonyms:       slices.Clone(w.Synonyms),
+		Level:          w.Level,
+		Tags:           slices.Clone(w.Tags),
+		Senses:         cloneSenses(w.Senses),
+	}
+}
+
+// applyContent replaces what editors write, leaving the word's identity and delivery state alone.
+func (w *Word) applyContent(content Content) {
+	w.Text = content.Text
+	w.EnglishMeaning = content.EnglishMeaning
+	w.KoreanMeanings = content.KoreanMeanings
+	w.PartsOfSpeech = content.PartsOfSpeech
+	w.IPA = content.IPA
+	w.AudioURL = content.AudioURL
+	w.Description = content.Description
+	w.Examples = content.Examples
+	w.Synonyms = content.Synonyms
+	w.Level = content.Level
+	w.Tags = content.Tags
+	w.Senses = content.Senses
+}
+
+// normalized validates content as written by an editor and puts it in the form it is stored in.
+// The senses are what is kept; content written without them, by clients that only know the flat
+// meaning fields, has its senses built from those fields, and the flat fields always end up
+// derived from the senses.
+func (c Content) normalized() (Content, error) {
+	if strings.TrimSpace(c.Text) == "" {
+		return Content{}, ErrTextRequired
+	}
+
+	level, err := ParseLevel(string(c.Level))
+	if err != nil {
+		return Content{}, err
+	}
+	if len(c.Senses) == 0 {
+		c.PartsOfSpeech, err = parsePartsOfSpeech(c.PartsOfSpeech, len(c.KoreanMeanings))
+		if err != nil {
+			return Content{}, err
+		}
+		c.Senses = SensesFromMeanings(c.EnglishMeaning, c.KoreanMeanings, c.PartsOfSpeech, c.Examples, c.Synonyms)
+	}
+	c.Senses, err = normalizeSenses(c.Senses)
+	if err != nil {
+		return Content{}, err
+	}
+	c.applySenses()
+	c.AudioURL, err = ParseAudioURL(c.AudioURL)
+	if err != nil {
+		return Content{}, err
+	}
+
+	c.Level = level
+	c.IPA = NormalizeIPA(c.IPA)
+	c.Tags = NormalizeTags(c.Tags)
+	return c, nil
+}
@@ -0,0 +1,150 @@
+package word
+
+import (
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// sortKey is one field words are listed by, 1 for ascending and -1 for descending.
+type sortKey struct {
+	Field string
+	Order int
+}
+
+// sortKeys is the full order of a listing. It always ends with _id, so no two words tie and a
+// cursor points at exactly one place in the listing.
+type sortKeys []sortKey
+
+func (keys sortKeys) withTieBreaker() sortKeys {
+	if len(keys) > 0 && keys[len(keys)-1].Field == "_id" {
+		return keys
+	}
+	order := 1
+	if len(keys) > 0 {
+		order = keys[len(keys)-1].Order
+	}
+	return append(keys, sortKey{Field: "_id", Order: order})
+}
+
+// collated tells whether the order compares text, which has to be done with textCollation.
+func (keys sortKeys) collated() bool {
+	return slices.ContainsFunc(keys, func(key sortKey) bool { return key.Field == "text" })
+}
+
+// servedBy tells whether index lists words in this order, going either way.
+func (keys sortKeys) servedBy(index sortKeys) bool {
+	if len(keys) != len(index) {
+		return false
+	}
+	direction := keys[0].Order * index[0].Order
+	for i, key := range keys {
+		if key.Field != index[i].Field || key.Order != direction*index[i].Order {
+			return false
+		}
+	}
+	return true
+}
+
+func (keys sortKeys) sort() bson.D {
+	sort := make(bson.D, 0, len(keys))
+	for _, key := range keys {
+		sort = append(sort, bson.E{Key: key.Field, Value: key.Order})
+	}
+	return sort
+}
+
+// String describes the order, e.g. "created_at:desc,_id:desc", to tell which listing a cursor belongs to.
+func (keys sortKeys) String() string {
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		order := "asc"
+		if key.Order < 0 {
+			order = "desc"
+		}
+		parts = append(parts, key.Field+":"+order)
+	}
+	return strings.Join(parts, ",")
+}
+
+// pageCursor is where a cursor-paginated listing stopped: the sort values of the last word of a page.
+type pageCursor struct {
+	Sort   string          `bson:"s"`
+	Values []bson.RawValue `bson:"v"`
+}
+
+// encodeCursor makes an opaque cursor pointing after last, a word as stored.
+func encodeCursor(keys sortKeys, last bson.Raw) (string, error) {
+	cursor := pageCursor{Sort: keys.String(), Values: make([]bson.RawValue, 0, len(keys))}
+	for _, key := range keys {
+		value, err := last.LookupErr(strings.Split(key.Field, ".")...)
+		if err != nil {
+			// A word stored without the field sorts as null.
+			value = bson.RawValue{Type: bson.TypeNull}
+		}
+		cursor.Values = append(cursor.Values, value)
+	}
+
+	encoded, err := bson.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+// decodeCursor reads a cursor made by encodeCursor for a listing in the same order.
+func decodeCursor(keys sortKeys, encoded string) (*pageCursor, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var cursor pageCursor
+	if err := bson.Unmarshal(decoded, &cursor); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.Sort != keys.String() || len(cursor.Values) != len(keys) {
+		return nil, fmt.Errorf("%w: it was made for a listing sorted by %s", ErrInvalidCursor, cursor.Sort)
+	}
+	return &cursor, nil
+}
+
+// after filters a listing down to the words that come after the cursor: those past it on the first key,
+// or equal on the first key and past it on the second, and so on.
+func (c *pageCursor) after(keys sortKeys) bson.M {
+	alternatives := make(bson.A, 0, len(keys))
+	for i, key := range keys {
+		equal := bson.M{}
+		for j := range i {
+			equal[keys[j].Field] = c.Values[j]
+		}
+		for _, past := range beyond(key, c.Values[i]) {
+			condition := maps.Clone(equal)
+			condition[key.Field] = past
+			alternatives = append(alternatives, condition)
+		}
+	}
+	return bson.M{"$or": alternatives}
+}
+
+// beyond returns what a field may match to come after value in the order of key. Words without the field
+// sort first, as null, but MongoDB compares null only with null, so $gt and $lt never reach across it:
+// they are matched on their own, with nil matching both null and a missing field.
+func beyond(key sortKey, value bson.RawValue) []any {
+	isNull := value.Type == bson.TypeNull
+	switch {
+	case key.Order > 0 && isNull:
+		return []any{bson.M{"$ne": nil}}
+	case key.Order > 0:
+		return []any{bson.M{"$gt": value}}
+	case isNull:
+		return nil
+	case key.Field == "_id":
+		return []any{bson.M{"$lt": value}}
+	default:
+		return []any{bson.M{"$lt": value}, nil}
+	}
+}
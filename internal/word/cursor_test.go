@@ -0,0 +1,118 @@
+package word
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPageCursor(t *testing.T) {
+	keys := sortKeys{{Field: "delivered_at", Order: -1}}.withTieBreaker()
+	id := primitive.NewObjectID()
+	deliveredAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	last, err := bson.Marshal(bson.M{"_id": id, "text": "test", "delivered_at": deliveredAt})
+	require.NoError(t, err)
+
+	t.Run("Round Trip", func(t *testing.T) {
+		encoded, err := encodeCursor(keys, last)
+		require.NoError(t, err)
+
+		cursor, err := decodeCursor(keys, encoded)
+		require.NoError(t, err)
+		require.Len(t, cursor.Values, 2)
+		assert.Equal(t, deliveredAt, cursor.Values[0].Time().UTC())
+		assert.Equal(t, id, cursor.Values[1].ObjectID())
+	})
+
+	t.Run("Words After The Cursor", func(t *testing.T) {
+		encoded, _ := encodeCursor(keys, last)
+		cursor, _ := decodeCursor(keys, encoded)
+
+		after := cursor.after(keys)["$or"].(bson.A)
+		require.Len(t, after, 3)
+		assert.Equal(t, bson.M{"delivered_at": bson.M{"$lt": cursor.Values[0]}}, after[0])
+		assert.Equal(t, bson.M{"delivered_at": nil}, after[1], "Expected words without the field to come last")
+		assert.Equal(t, bson.M{"delivered_at": cursor.Values[0], "_id": bson.M{"$lt": cursor.Values[1]}}, after[2])
+	})
+
+	t.Run("Words After A Missing Field", func(t *testing.T) {
+		byLevel := sortKeys{{Field: "level", Order: 1}}.withTieBreaker()
+		encoded, err := encodeCursor(byLevel, last)
+		require.NoError(t, err)
+		cursor, err := decodeCursor(byLevel, encoded)
+		require.NoError(t, err)
+		require.Equal(t, bson.TypeNull, cursor.Values[0].Type)
+
+		after := cursor.after(byLevel)["$or"].(bson.A)
+		require.Len(t, after, 2)
+		assert.Equal(t, bson.M{"level": bson.M{"$ne": nil}}, after[0], "Expected every word with a level to follow")
+		assert.Equal(t, bson.M{"level": cursor.Values[0], "_id": bson.M{"$gt": cursor.Values[1]}}, after[1])
+
+		descending := sortKeys{{Field: "level", Order: -1}}.withTieBreaker()
+		encoded, _ = encodeCursor(descending, last)
+		cursor, _ = decodeCursor(descending, encoded)
+		after = cursor.after(descending)["$or"].(bson.A)
+		require.Len(t, after, 1, "Expected nothing but other words without a level after one, sorted descending")
+	})
+
+	t.Run("Failure-Another Order", func(t *testing.T) {
+		encoded, _ := encodeCursor(keys, last)
+
+		_, err := decodeCursor(sortKeys{{Field: "created_at", Order: -1}}.withTieBreaker(), encoded)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("Failure-Garbage", func(t *testing.T) {
+		for _, encoded := range []string{"not a cursor!", "bm90IGJzb24"} {
+			_, err := decodeCursor(keys, encoded)
+			assert.ErrorIs(t, err, ErrInvalidCursor, encoded)
+		}
+	})
+}
+
+func TestParseSort(t *testing.T) {
+	t.Run("Several Fields", func(t *testing.T) {
+		keys, err := setupSort(&SearchParams{Sort: "delivered_at:desc, text:asc"})
+		require.NoError(t, err)
+		assert.Equal(t, "delivered_at:desc,text:asc,_id:asc", keys.String())
+		assert.True(t, keys.collated())
+
+		keys, err = setupSort(&SearchParams{Sort: "delivered_at:asc,text:desc"})
+		require.NoError(t, err)
+		assert.Equal(t, "delivered_at:asc,text:desc,_id:desc", keys.String(), "Expected the index to serve the opposite order too")
+	})
+
+	t.Run("Single Fields", func(t *testing.T) {
+		for field := range allowedSortFields {
+			for _, order := range []string{"asc", "desc"} {
+				_, err := setupSort(&SearchParams{Sort: field + ":" + order})
+				assert.NoError(t, err, field+":"+order)
+			}
+		}
+	})
+
+	t.Run("Failure-Not Indexed", func(t *testing.T) {
+		for _, sort := range []string{"level,text", "updated_at,text", "delivered_at:desc,text:desc", "text,delivered_at"} {
+			_, err := setupSort(&SearchParams{Sort: sort})
+			assert.ErrorIs(t, err, ErrInvalidSort, sort)
+		}
+	})
+
+	t.Run("Sort By And Order Without Sort", func(t *testing.T) {
+		keys, err := setupSort(&SearchParams{SortBy: "updated_at", SortOrder: "asc"})
+		require.NoError(t, err)
+		assert.Equal(t, "updated_at:asc,_id:asc", keys.String())
+		assert.False(t, keys.collated())
+	})
+
+	t.Run("Failure", func(t *testing.T) {
+		for _, sort := range []string{"meaning", "text:up", "text,text:desc", "text,", ":asc"} {
+			_, err := setupSort(&SearchParams{Sort: sort})
+			assert.ErrorIs(t, err, ErrInvalidSort, sort)
+		}
+	})
+}
@@ -1,20 +1,84 @@
 package word
 
 type SaveWordDto struct {
-	Text           string    `json:"text" validate:"required"`
-	EnglishMeaning string    `json:"english_meaning,omitempty"`
-	KoreanMeanings []string  `json:"korean_meaning,omitempty"`
-	Description    string    `json:"description,omitempty"`
-	Examples       []Example `json:"examples,omitempty"`
-	Synonyms       []string  `json:"synonyms,omitempty"`
+	Text           string   `json:"text" validate:"required"`
+	EnglishMeaning string   `json:"english_meaning,omitempty"`
+	KoreanMeanings []string `json:"korean_meaning,omitempty"`
+	// PartsOfSpeech holds the part of speech of each Korean meaning, in the same order.
+	PartsOfSpeech []string  `json:"part_of_speech,omitempty"`
+	IPA           string    `json:"ipa,omitempty"`
+	AudioURL      string    `json:"audio_url,omitempty"`
+	Description   string    `json:"description,omitempty"`
+	Examples      []Example `json:"examples,omitempty"`
+	Synonyms      []string  `json:"synonyms,omitempty"`
+	Level         string    `json:"level,omitempty"`
+	Tags          []string  `json:"tags,omitempty"`
+	// Senses, when given, replace the flat meaning fields above, which are then derived from them.
+	Senses []Sense `json:"senses,omitempty"`
+	// Editor names who made the change, for the word's revision history.
+	Editor string `json:"-"`
 }
 
 type UpdateWordDto struct {
-	ID             string    `json:"id" validate:"required"`
-	Text           string    `json:"text" validate:"required"`
-	EnglishMeaning string    `json:"english_meaning,omitempty"`
-	KoreanMeanings []string  `json:"korean_meaning,omitempty"`
-	Description    string    `json:"description,omitempty"`
-	Examples       []Example `json:"examples,omitempty"`
-	Synonyms       []string  `json:"synonyms,omitempty"`
+	ID             string   `json:"id" validate:"required"`
+	Text           string   `json:"text" validate:"required"`
+	EnglishMeaning string   `json:"english_meaning,omitempty"`
+	KoreanMeanings []string `json:"korean_meaning,omitempty"`
+	// PartsOfSpeech holds the part of speech of each Korean meaning, in the same order.
+	PartsOfSpeech []string  `json:"part_of_speech,omitempty"`
+	IPA           string    `json:"ipa,omitempty"`
+	AudioURL      string    `json:"audio_url,omitempty"`
+	Description   string    `json:"description,omitempty"`
+	Examples      []Example `json:"examples,omitempty"`
+	Synonyms      []string  `json:"synonyms,omitempty"`
+	Level         string    `json:"level,omitempty"`
+	Tags          []string  `json:"tags,omitempty"`
+	// Senses, when given, replace the flat meaning fields above, which are then derived from them.
+	Senses []Sense `json:"senses,omitempty"`
+	// Editor names who made the change, for the word's revision history.
+	Editor string `json:"-"`
+	// Version is the version of the word the editor last read, nil to overwrite whatever is stored.
+	Version *int `json:"-"`
+}
+
+func (dto *SaveWordDto) content() (Content, error) {
+	return Content{
+		Text:           dto.Text,
+		EnglishMeaning: dto.EnglishMeaning,
+		KoreanMeanings: dto.KoreanMeanings,
+		PartsOfSpeech:  toPartsOfSpeech(dto.PartsOfSpeech),
+		IPA:            dto.IPA,
+		AudioURL:       dto.AudioURL,
+		Description:    dto.Description,
+		Examples:       dto.Examples,
+		Synonyms:       dto.Synonyms,
+		Level:          Level(dto.Level),
+		Tags:           dto.Tags,
+		Senses:         dto.Senses,
+	}.normalized()
+}
+
+func (dto *UpdateWordDto) content() (Content, error) {
+	return Content{
+		Text:           dto.Text,
+		EnglishMeaning: dto.EnglishMeaning,
+		KoreanMeanings: dto.KoreanMeanings,
+		PartsOfSpeech:  toPartsOfSpeech(dto.PartsOfSpeech),
+		IPA:            dto.IPA,
+		AudioURL:       dto.AudioURL,
+		Description:    dto.Description,
+		Examples:       dto.Examples,
+		Synonyms:       dto.Synonyms,
+		Level:          Level(dto.Level),
+		Tags:           dto.Tags,
+		Senses:         dto.Senses,
+	}.normalized()
+}
+
+func toPartsOfSpeech(values []string) []PartOfSpeech {
+	partsOfSpeech := make([]PartOfSpeech, 0, len(values))
+	for _, value := range values {
+		partsOfSpeech = append(partsOfSpeech, PartOfSpeech(value))
+	}
+	return partsOfSpeech
 }
@@ -0,0 +1,28 @@
+package word
+
+import "errors"
+
+var (
+	ErrNoWordAvailable     = errors.New("no word left to deliver")
+	ErrInvalidLevel        = errors.New("invalid level")
+	ErrWordNotFound        = errors.New("word not found")
+	ErrRevisionNotFound    = errors.New("revision not found")
+	ErrRevisionExists      = errors.New("revision number already taken")
+	ErrInvalidPatch        = errors.New("invalid merge patch")
+	ErrVersionConflict     = errors.New("word was changed by someone else")
+	ErrInvalidStatus       = errors.New("invalid status")
+	ErrInvalidTransition   = errors.New("status change not allowed")
+	ErrTextRequired        = errors.New("word is required")
+	ErrInvalidPartOfSpeech = errors.New("invalid part of speech")
+	ErrInvalidAudioURL     = errors.New("invalid audio URL")
+	ErrReviewerRequired    = errors.New("reviewer must be named to approve a word")
+	ErrSelfApproval        = errors.New("a word cannot be approved by its own author")
+	ErrEmptyComment        = errors.New("comment must not be empty")
+	ErrAttachmentNotFound  = errors.New("attachment not found")
+	ErrInvalidRelationType = errors.New("invalid relation type")
+	ErrRelationNotFound    = errors.New("relation not found")
+	ErrRelationExists      = errors.New("words are already related that way")
+	ErrSelfRelation        = errors.New("a word cannot be related to itself")
+	ErrInvalidCursor       = errors.New("invalid cursor")
+	ErrInvalidSort         = errors.New("invalid sort")
+)
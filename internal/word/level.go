@@ -0,0 +1,56 @@
+package word
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Level is a CEFR-style difficulty level, from A1 (beginner) to C2 (proficient).
+type Level string
+
+const (
+	LevelA1 Level = "A1"
+	LevelA2 Level = "A2"
+	LevelB1 Level = "B1"
+	LevelB2 Level = "B2"
+	LevelC1 Level = "C1"
+	LevelC2 Level = "C2"
+)
+
+var Levels = []Level{LevelA1, LevelA2, LevelB1, LevelB2, LevelC1, LevelC2}
+
+// ParseLevel accepts a level in either case; an empty value means the word has no level.
+func ParseLevel(value string) (Level, error) {
+	level := Level(strings.ToUpper(strings.TrimSpace(value)))
+	if level == "" || slices.Contains(Levels, level) {
+		return level, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidLevel, value)
+}
+
+func ParseLevels(values []string) ([]Level, error) {
+	levels := make([]Level, 0, len(values))
+	for _, value := range values {
+		level, err := ParseLevel(value)
+		if err != nil {
+			return nil, err
+		}
+		if level != "" && !slices.Contains(levels, level) {
+			levels = append(levels, level)
+		}
+	}
+	return levels, nil
+}
+
+// NormalizeTags lower-cases and trims tags and drops empty and duplicated ones.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,38 @@
+package word
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Level
+		wantErr bool
+	}{
+		{name: "Upper Case", value: "B2", want: LevelB2},
+		{name: "Lower Case", value: " c1 ", want: LevelC1},
+		{name: "Empty", value: "", want: ""},
+		{name: "Unknown", value: "D1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, err := ParseLevel(tt.value)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidLevel))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, level)
+		})
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Equal(t, []string{"travel", "food"}, NormalizeTags([]string{" Travel", "food", "", "TRAVEL"}))
+}
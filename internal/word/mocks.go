@@ -0,0 +1,1365 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package word
+
+import (
+	"github.com/Go-roro/wordrop/internal/common"
+	mock "github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// NewMockRepository creates a new instance of MockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRepository {
+	mock := &MockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRepository is an autogenerated mock type for the Repository type
+type MockRepository struct {
+	mock.Mock
+}
+
+type MockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
+	return &MockRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindById provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindById(id string) (*Word, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindById")
+	}
+
+	var r0 *Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*Word, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *Word); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindById'
+type MockRepository_FindById_Call struct {
+	*mock.Call
+}
+
+// FindById is a helper method to define mock.On call
+//   - id string
+func (_e *MockRepository_Expecter) FindById(id interface{}) *MockRepository_FindById_Call {
+	return &MockRepository_FindById_Call{Call: _e.mock.On("FindById", id)}
+}
+
+func (_c *MockRepository_FindById_Call) Run(run func(id string)) *MockRepository_FindById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindById_Call) Return(word *Word, err error) *MockRepository_FindById_Call {
+	_c.Call.Return(word, err)
+	return _c
+}
+
+func (_c *MockRepository_FindById_Call) RunAndReturn(run func(id string) (*Word, error)) *MockRepository_FindById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByIds provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByIds(ids []primitive.ObjectID) ([]*Word, error) {
+	ret := _mock.Called(ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByIds")
+	}
+
+	var r0 []*Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]primitive.ObjectID) ([]*Word, error)); ok {
+		return returnFunc(ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]primitive.ObjectID) []*Word); ok {
+		r0 = returnFunc(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]primitive.ObjectID) error); ok {
+		r1 = returnFunc(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByIds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByIds'
+type MockRepository_FindByIds_Call struct {
+	*mock.Call
+}
+
+// FindByIds is a helper method to define mock.On call
+//   - ids []primitive.ObjectID
+func (_e *MockRepository_Expecter) FindByIds(ids interface{}) *MockRepository_FindByIds_Call {
+	return &MockRepository_FindByIds_Call{Call: _e.mock.On("FindByIds", ids)}
+}
+
+func (_c *MockRepository_FindByIds_Call) Run(run func(ids []primitive.ObjectID)) *MockRepository_FindByIds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].([]primitive.ObjectID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByIds_Call) Return(words []*Word, err error) *MockRepository_FindByIds_Call {
+	_c.Call.Return(words, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByIds_Call) RunAndReturn(run func(ids []primitive.ObjectID) ([]*Word, error)) *MockRepository_FindByIds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBySynonym provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBySynonym(text string) ([]*Word, error) {
+	ret := _mock.Called(text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBySynonym")
+	}
+
+	var r0 []*Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]*Word, error)); ok {
+		return returnFunc(text)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []*Word); ok {
+		r0 = returnFunc(text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(text)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindBySynonym_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBySynonym'
+type MockRepository_FindBySynonym_Call struct {
+	*mock.Call
+}
+
+// FindBySynonym is a helper method to define mock.On call
+//   - text string
+func (_e *MockRepository_Expecter) FindBySynonym(text interface{}) *MockRepository_FindBySynonym_Call {
+	return &MockRepository_FindBySynonym_Call{Call: _e.mock.On("FindBySynonym", text)}
+}
+
+func (_c *MockRepository_FindBySynonym_Call) Run(run func(text string)) *MockRepository_FindBySynonym_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindBySynonym_Call) Return(words []*Word, err error) *MockRepository_FindBySynonym_Call {
+	_c.Call.Return(words, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBySynonym_Call) RunAndReturn(run func(text string) ([]*Word, error)) *MockRepository_FindBySynonym_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByTexts provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindByTexts(texts []string) ([]*Word, error) {
+	ret := _mock.Called(texts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByTexts")
+	}
+
+	var r0 []*Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) ([]*Word, error)); ok {
+		return returnFunc(texts)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) []*Word); ok {
+		r0 = returnFunc(texts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(texts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindByTexts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByTexts'
+type MockRepository_FindByTexts_Call struct {
+	*mock.Call
+}
+
+// FindByTexts is a helper method to define mock.On call
+//   - texts []string
+func (_e *MockRepository_Expecter) FindByTexts(texts interface{}) *MockRepository_FindByTexts_Call {
+	return &MockRepository_FindByTexts_Call{Call: _e.mock.On("FindByTexts", texts)}
+}
+
+func (_c *MockRepository_FindByTexts_Call) Run(run func(texts []string)) *MockRepository_FindByTexts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindByTexts_Call) Return(words []*Word, err error) *MockRepository_FindByTexts_Call {
+	_c.Call.Return(words, err)
+	return _c
+}
+
+func (_c *MockRepository_FindByTexts_Call) RunAndReturn(run func(texts []string) ([]*Word, error)) *MockRepository_FindByTexts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDeliveredByText provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindDeliveredByText(text string) (*Word, error) {
+	ret := _mock.Called(text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDeliveredByText")
+	}
+
+	var r0 *Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*Word, error)); ok {
+		return returnFunc(text)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *Word); ok {
+		r0 = returnFunc(text)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(text)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindDeliveredByText_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeliveredByText'
+type MockRepository_FindDeliveredByText_Call struct {
+	*mock.Call
+}
+
+// FindDeliveredByText is a helper method to define mock.On call
+//   - text string
+func (_e *MockRepository_Expecter) FindDeliveredByText(text interface{}) *MockRepository_FindDeliveredByText_Call {
+	return &MockRepository_FindDeliveredByText_Call{Call: _e.mock.On("FindDeliveredByText", text)}
+}
+
+func (_c *MockRepository_FindDeliveredByText_Call) Run(run func(text string)) *MockRepository_FindDeliveredByText_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindDeliveredByText_Call) Return(word *Word, err error) *MockRepository_FindDeliveredByText_Call {
+	_c.Call.Return(word, err)
+	return _c
+}
+
+func (_c *MockRepository_FindDeliveredByText_Call) RunAndReturn(run func(text string) (*Word, error)) *MockRepository_FindDeliveredByText_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLatestDelivered provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindLatestDelivered() (*Word, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLatestDelivered")
+	}
+
+	var r0 *Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*Word, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *Word); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindLatestDelivered_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLatestDelivered'
+type MockRepository_FindLatestDelivered_Call struct {
+	*mock.Call
+}
+
+// FindLatestDelivered is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) FindLatestDelivered() *MockRepository_FindLatestDelivered_Call {
+	return &MockRepository_FindLatestDelivered_Call{Call: _e.mock.On("FindLatestDelivered")}
+}
+
+func (_c *MockRepository_FindLatestDelivered_Call) Run(run func()) *MockRepository_FindLatestDelivered_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindLatestDelivered_Call) Return(word *Word, err error) *MockRepository_FindLatestDelivered_Call {
+	_c.Call.Return(word, err)
+	return _c
+}
+
+func (_c *MockRepository_FindLatestDelivered_Call) RunAndReturn(run func() (*Word, error)) *MockRepository_FindLatestDelivered_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindNextWord provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindNextWord(selection *Selection) (*Word, error) {
+	ret := _mock.Called(selection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindNextWord")
+	}
+
+	var r0 *Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*Selection) (*Word, error)); ok {
+		return returnFunc(selection)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*Selection) *Word); ok {
+		r0 = returnFunc(selection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*Selection) error); ok {
+		r1 = returnFunc(selection)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindNextWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindNextWord'
+type MockRepository_FindNextWord_Call struct {
+	*mock.Call
+}
+
+// FindNextWord is a helper method to define mock.On call
+//   - selection *Selection
+func (_e *MockRepository_Expecter) FindNextWord(selection interface{}) *MockRepository_FindNextWord_Call {
+	return &MockRepository_FindNextWord_Call{Call: _e.mock.On("FindNextWord", selection)}
+}
+
+func (_c *MockRepository_FindNextWord_Call) Run(run func(selection *Selection)) *MockRepository_FindNextWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Selection
+		if args[0] != nil {
+			arg0 = args[0].(*Selection)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindNextWord_Call) Return(word *Word, err error) *MockRepository_FindNextWord_Call {
+	_c.Call.Return(word, err)
+	return _c
+}
+
+func (_c *MockRepository_FindNextWord_Call) RunAndReturn(run func(selection *Selection) (*Word, error)) *MockRepository_FindNextWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUndeliveredWords provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindUndeliveredWords(excludeIDs []primitive.ObjectID, limit int) ([]*Word, error) {
+	ret := _mock.Called(excludeIDs, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUndeliveredWords")
+	}
+
+	var r0 []*Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]primitive.ObjectID, int) ([]*Word, error)); ok {
+		return returnFunc(excludeIDs, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]primitive.ObjectID, int) []*Word); ok {
+		r0 = returnFunc(excludeIDs, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]primitive.ObjectID, int) error); ok {
+		r1 = returnFunc(excludeIDs, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindUndeliveredWords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUndeliveredWords'
+type MockRepository_FindUndeliveredWords_Call struct {
+	*mock.Call
+}
+
+// FindUndeliveredWords is a helper method to define mock.On call
+//   - excludeIDs []primitive.ObjectID
+//   - limit int
+func (_e *MockRepository_Expecter) FindUndeliveredWords(excludeIDs interface{}, limit interface{}) *MockRepository_FindUndeliveredWords_Call {
+	return &MockRepository_FindUndeliveredWords_Call{Call: _e.mock.On("FindUndeliveredWords", excludeIDs, limit)}
+}
+
+func (_c *MockRepository_FindUndeliveredWords_Call) Run(run func(excludeIDs []primitive.ObjectID, limit int)) *MockRepository_FindUndeliveredWords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].([]primitive.ObjectID)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindUndeliveredWords_Call) Return(words []*Word, err error) *MockRepository_FindUndeliveredWords_Call {
+	_c.Call.Return(words, err)
+	return _c
+}
+
+func (_c *MockRepository_FindUndeliveredWords_Call) RunAndReturn(run func(excludeIDs []primitive.ObjectID, limit int) ([]*Word, error)) *MockRepository_FindUndeliveredWords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWords provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindWords(params *SearchParams) (*common.PageResult[*Word], error) {
+	ret := _mock.Called(params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWords")
+	}
+
+	var r0 *common.PageResult[*Word]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*SearchParams) (*common.PageResult[*Word], error)); ok {
+		return returnFunc(params)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*SearchParams) *common.PageResult[*Word]); ok {
+		r0 = returnFunc(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*common.PageResult[*Word])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*SearchParams) error); ok {
+		r1 = returnFunc(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindWords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWords'
+type MockRepository_FindWords_Call struct {
+	*mock.Call
+}
+
+// FindWords is a helper method to define mock.On call
+//   - params *SearchParams
+func (_e *MockRepository_Expecter) FindWords(params interface{}) *MockRepository_FindWords_Call {
+	return &MockRepository_FindWords_Call{Call: _e.mock.On("FindWords", params)}
+}
+
+func (_c *MockRepository_FindWords_Call) Run(run func(params *SearchParams)) *MockRepository_FindWords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *SearchParams
+		if args[0] != nil {
+			arg0 = args[0].(*SearchParams)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindWords_Call) Return(pageResult *common.PageResult[*Word], err error) *MockRepository_FindWords_Call {
+	_c.Call.Return(pageResult, err)
+	return _c
+}
+
+func (_c *MockRepository_FindWords_Call) RunAndReturn(run func(params *SearchParams) (*common.PageResult[*Word], error)) *MockRepository_FindWords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrateSenses provides a mock function for the type MockRepository
+func (_mock *MockRepository) MigrateSenses() (int64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateSenses")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_MigrateSenses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateSenses'
+type MockRepository_MigrateSenses_Call struct {
+	*mock.Call
+}
+
+// MigrateSenses is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) MigrateSenses() *MockRepository_MigrateSenses_Call {
+	return &MockRepository_MigrateSenses_Call{Call: _e.mock.On("MigrateSenses")}
+}
+
+func (_c *MockRepository_MigrateSenses_Call) Run(run func()) *MockRepository_MigrateSenses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_MigrateSenses_Call) Return(n int64, err error) *MockRepository_MigrateSenses_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_MigrateSenses_Call) RunAndReturn(run func() (int64, error)) *MockRepository_MigrateSenses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrateStatuses provides a mock function for the type MockRepository
+func (_mock *MockRepository) MigrateStatuses() (int64, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateStatuses")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (int64, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() int64); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_MigrateStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateStatuses'
+type MockRepository_MigrateStatuses_Call struct {
+	*mock.Call
+}
+
+// MigrateStatuses is a helper method to define mock.On call
+func (_e *MockRepository_Expecter) MigrateStatuses() *MockRepository_MigrateStatuses_Call {
+	return &MockRepository_MigrateStatuses_Call{Call: _e.mock.On("MigrateStatuses")}
+}
+
+func (_c *MockRepository_MigrateStatuses_Call) Run(run func()) *MockRepository_MigrateStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRepository_MigrateStatuses_Call) Return(n int64, err error) *MockRepository_MigrateStatuses_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockRepository_MigrateStatuses_Call) RunAndReturn(run func() (int64, error)) *MockRepository_MigrateStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SampleWords provides a mock function for the type MockRepository
+func (_mock *MockRepository) SampleWords(size int, excludeIDs []primitive.ObjectID) ([]*Word, error) {
+	ret := _mock.Called(size, excludeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SampleWords")
+	}
+
+	var r0 []*Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, []primitive.ObjectID) ([]*Word, error)); ok {
+		return returnFunc(size, excludeIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, []primitive.ObjectID) []*Word); ok {
+		r0 = returnFunc(size, excludeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, []primitive.ObjectID) error); ok {
+		r1 = returnFunc(size, excludeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_SampleWords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SampleWords'
+type MockRepository_SampleWords_Call struct {
+	*mock.Call
+}
+
+// SampleWords is a helper method to define mock.On call
+//   - size int
+//   - excludeIDs []primitive.ObjectID
+func (_e *MockRepository_Expecter) SampleWords(size interface{}, excludeIDs interface{}) *MockRepository_SampleWords_Call {
+	return &MockRepository_SampleWords_Call{Call: _e.mock.On("SampleWords", size, excludeIDs)}
+}
+
+func (_c *MockRepository_SampleWords_Call) Run(run func(size int, excludeIDs []primitive.ObjectID)) *MockRepository_SampleWords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 []primitive.ObjectID
+		if args[1] != nil {
+			arg1 = args[1].([]primitive.ObjectID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SampleWords_Call) Return(words []*Word, err error) *MockRepository_SampleWords_Call {
+	_c.Call.Return(words, err)
+	return _c
+}
+
+func (_c *MockRepository_SampleWords_Call) RunAndReturn(run func(size int, excludeIDs []primitive.ObjectID) ([]*Word, error)) *MockRepository_SampleWords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveWord provides a mock function for the type MockRepository
+func (_mock *MockRepository) SaveWord(word *Word) (*Word, error) {
+	ret := _mock.Called(word)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWord")
+	}
+
+	var r0 *Word
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*Word) (*Word, error)); ok {
+		return returnFunc(word)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*Word) *Word); ok {
+		r0 = returnFunc(word)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Word)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*Word) error); ok {
+		r1 = returnFunc(word)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_SaveWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWord'
+type MockRepository_SaveWord_Call struct {
+	*mock.Call
+}
+
+// SaveWord is a helper method to define mock.On call
+//   - word *Word
+func (_e *MockRepository_Expecter) SaveWord(word interface{}) *MockRepository_SaveWord_Call {
+	return &MockRepository_SaveWord_Call{Call: _e.mock.On("SaveWord", word)}
+}
+
+func (_c *MockRepository_SaveWord_Call) Run(run func(word *Word)) *MockRepository_SaveWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Word
+		if args[0] != nil {
+			arg0 = args[0].(*Word)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_SaveWord_Call) Return(word1 *Word, err error) *MockRepository_SaveWord_Call {
+	_c.Call.Return(word1, err)
+	return _c
+}
+
+func (_c *MockRepository_SaveWord_Call) RunAndReturn(run func(word *Word) (*Word, error)) *MockRepository_SaveWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateWord provides a mock function for the type MockRepository
+func (_mock *MockRepository) UpdateWord(word *Word) error {
+	ret := _mock.Called(word)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateWord")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*Word) error); ok {
+		r0 = returnFunc(word)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_UpdateWord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWord'
+type MockRepository_UpdateWord_Call struct {
+	*mock.Call
+}
+
+// UpdateWord is a helper method to define mock.On call
+//   - word *Word
+func (_e *MockRepository_Expecter) UpdateWord(word interface{}) *MockRepository_UpdateWord_Call {
+	return &MockRepository_UpdateWord_Call{Call: _e.mock.On("UpdateWord", word)}
+}
+
+func (_c *MockRepository_UpdateWord_Call) Run(run func(word *Word)) *MockRepository_UpdateWord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Word
+		if args[0] != nil {
+			arg0 = args[0].(*Word)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_UpdateWord_Call) Return(err error) *MockRepository_UpdateWord_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_UpdateWord_Call) RunAndReturn(run func(word *Word) error) *MockRepository_UpdateWord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRevisions creates a new instance of MockRevisions. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRevisions(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRevisions {
+	mock := &MockRevisions{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRevisions is an autogenerated mock type for the Revisions type
+type MockRevisions struct {
+	mock.Mock
+}
+
+type MockRevisions_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRevisions) EXPECT() *MockRevisions_Expecter {
+	return &MockRevisions_Expecter{mock: &_m.Mock}
+}
+
+// FindLatestRevision provides a mock function for the type MockRevisions
+func (_mock *MockRevisions) FindLatestRevision(wordID primitive.ObjectID) (*Revision, error) {
+	ret := _mock.Called(wordID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLatestRevision")
+	}
+
+	var r0 *Revision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) (*Revision, error)); ok {
+		return returnFunc(wordID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) *Revision); ok {
+		r0 = returnFunc(wordID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Revision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = returnFunc(wordID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevisions_FindLatestRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLatestRevision'
+type MockRevisions_FindLatestRevision_Call struct {
+	*mock.Call
+}
+
+// FindLatestRevision is a helper method to define mock.On call
+//   - wordID primitive.ObjectID
+func (_e *MockRevisions_Expecter) FindLatestRevision(wordID interface{}) *MockRevisions_FindLatestRevision_Call {
+	return &MockRevisions_FindLatestRevision_Call{Call: _e.mock.On("FindLatestRevision", wordID)}
+}
+
+func (_c *MockRevisions_FindLatestRevision_Call) Run(run func(wordID primitive.ObjectID)) *MockRevisions_FindLatestRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevisions_FindLatestRevision_Call) Return(revision *Revision, err error) *MockRevisions_FindLatestRevision_Call {
+	_c.Call.Return(revision, err)
+	return _c
+}
+
+func (_c *MockRevisions_FindLatestRevision_Call) RunAndReturn(run func(wordID primitive.ObjectID) (*Revision, error)) *MockRevisions_FindLatestRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRevision provides a mock function for the type MockRevisions
+func (_mock *MockRevisions) FindRevision(wordID primitive.ObjectID, number int) (*Revision, error) {
+	ret := _mock.Called(wordID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRevision")
+	}
+
+	var r0 *Revision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, int) (*Revision, error)); ok {
+		return returnFunc(wordID, number)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID, int) *Revision); ok {
+		r0 = returnFunc(wordID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Revision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID, int) error); ok {
+		r1 = returnFunc(wordID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevisions_FindRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRevision'
+type MockRevisions_FindRevision_Call struct {
+	*mock.Call
+}
+
+// FindRevision is a helper method to define mock.On call
+//   - wordID primitive.ObjectID
+//   - number int
+func (_e *MockRevisions_Expecter) FindRevision(wordID interface{}, number interface{}) *MockRevisions_FindRevision_Call {
+	return &MockRevisions_FindRevision_Call{Call: _e.mock.On("FindRevision", wordID, number)}
+}
+
+func (_c *MockRevisions_FindRevision_Call) Run(run func(wordID primitive.ObjectID, number int)) *MockRevisions_FindRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevisions_FindRevision_Call) Return(revision *Revision, err error) *MockRevisions_FindRevision_Call {
+	_c.Call.Return(revision, err)
+	return _c
+}
+
+func (_c *MockRevisions_FindRevision_Call) RunAndReturn(run func(wordID primitive.ObjectID, number int) (*Revision, error)) *MockRevisions_FindRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRevisions provides a mock function for the type MockRevisions
+func (_mock *MockRevisions) FindRevisions(wordID primitive.ObjectID) ([]*Revision, error) {
+	ret := _mock.Called(wordID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRevisions")
+	}
+
+	var r0 []*Revision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) ([]*Revision, error)); ok {
+		return returnFunc(wordID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) []*Revision); ok {
+		r0 = returnFunc(wordID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Revision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = returnFunc(wordID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevisions_FindRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRevisions'
+type MockRevisions_FindRevisions_Call struct {
+	*mock.Call
+}
+
+// FindRevisions is a helper method to define mock.On call
+//   - wordID primitive.ObjectID
+func (_e *MockRevisions_Expecter) FindRevisions(wordID interface{}) *MockRevisions_FindRevisions_Call {
+	return &MockRevisions_FindRevisions_Call{Call: _e.mock.On("FindRevisions", wordID)}
+}
+
+func (_c *MockRevisions_FindRevisions_Call) Run(run func(wordID primitive.ObjectID)) *MockRevisions_FindRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevisions_FindRevisions_Call) Return(revisions []*Revision, err error) *MockRevisions_FindRevisions_Call {
+	_c.Call.Return(revisions, err)
+	return _c
+}
+
+func (_c *MockRevisions_FindRevisions_Call) RunAndReturn(run func(wordID primitive.ObjectID) ([]*Revision, error)) *MockRevisions_FindRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRevision provides a mock function for the type MockRevisions
+func (_mock *MockRevisions) SaveRevision(revision *Revision) (*Revision, error) {
+	ret := _mock.Called(revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRevision")
+	}
+
+	var r0 *Revision
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*Revision) (*Revision, error)); ok {
+		return returnFunc(revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*Revision) *Revision); ok {
+		r0 = returnFunc(revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Revision)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*Revision) error); ok {
+		r1 = returnFunc(revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRevisions_SaveRevision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRevision'
+type MockRevisions_SaveRevision_Call struct {
+	*mock.Call
+}
+
+// SaveRevision is a helper method to define mock.On call
+//   - revision *Revision
+func (_e *MockRevisions_Expecter) SaveRevision(revision interface{}) *MockRevisions_SaveRevision_Call {
+	return &MockRevisions_SaveRevision_Call{Call: _e.mock.On("SaveRevision", revision)}
+}
+
+func (_c *MockRevisions_SaveRevision_Call) Run(run func(revision *Revision)) *MockRevisions_SaveRevision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Revision
+		if args[0] != nil {
+			arg0 = args[0].(*Revision)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRevisions_SaveRevision_Call) Return(revision1 *Revision, err error) *MockRevisions_SaveRevision_Call {
+	_c.Call.Return(revision1, err)
+	return _c
+}
+
+func (_c *MockRevisions_SaveRevision_Call) RunAndReturn(run func(revision *Revision) (*Revision, error)) *MockRevisions_SaveRevision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRelations creates a new instance of MockRelations. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRelations(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRelations {
+	mock := &MockRelations{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRelations is an autogenerated mock type for the Relations type
+type MockRelations struct {
+	mock.Mock
+}
+
+type MockRelations_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRelations) EXPECT() *MockRelations_Expecter {
+	return &MockRelations_Expecter{mock: &_m.Mock}
+}
+
+// DeleteRelation provides a mock function for the type MockRelations
+func (_mock *MockRelations) DeleteRelation(id primitive.ObjectID) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRelation")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRelations_DeleteRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRelation'
+type MockRelations_DeleteRelation_Call struct {
+	*mock.Call
+}
+
+// DeleteRelation is a helper method to define mock.On call
+//   - id primitive.ObjectID
+func (_e *MockRelations_Expecter) DeleteRelation(id interface{}) *MockRelations_DeleteRelation_Call {
+	return &MockRelations_DeleteRelation_Call{Call: _e.mock.On("DeleteRelation", id)}
+}
+
+func (_c *MockRelations_DeleteRelation_Call) Run(run func(id primitive.ObjectID)) *MockRelations_DeleteRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRelations_DeleteRelation_Call) Return(err error) *MockRelations_DeleteRelation_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRelations_DeleteRelation_Call) RunAndReturn(run func(id primitive.ObjectID) error) *MockRelations_DeleteRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRelations provides a mock function for the type MockRelations
+func (_mock *MockRelations) FindRelations(wordID primitive.ObjectID) ([]*Relation, error) {
+	ret := _mock.Called(wordID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRelations")
+	}
+
+	var r0 []*Relation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) ([]*Relation, error)); ok {
+		return returnFunc(wordID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(primitive.ObjectID) []*Relation); ok {
+		r0 = returnFunc(wordID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Relation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(primitive.ObjectID) error); ok {
+		r1 = returnFunc(wordID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRelations_FindRelations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRelations'
+type MockRelations_FindRelations_Call struct {
+	*mock.Call
+}
+
+// FindRelations is a helper method to define mock.On call
+//   - wordID primitive.ObjectID
+func (_e *MockRelations_Expecter) FindRelations(wordID interface{}) *MockRelations_FindRelations_Call {
+	return &MockRelations_FindRelations_Call{Call: _e.mock.On("FindRelations", wordID)}
+}
+
+func (_c *MockRelations_FindRelations_Call) Run(run func(wordID primitive.ObjectID)) *MockRelations_FindRelations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 primitive.ObjectID
+		if args[0] != nil {
+			arg0 = args[0].(primitive.ObjectID)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRelations_FindRelations_Call) Return(relations []*Relation, err error) *MockRelations_FindRelations_Call {
+	_c.Call.Return(relations, err)
+	return _c
+}
+
+func (_c *MockRelations_FindRelations_Call) RunAndReturn(run func(wordID primitive.ObjectID) ([]*Relation, error)) *MockRelations_FindRelations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveRelation provides a mock function for the type MockRelations
+func (_mock *MockRelations) SaveRelation(relation *Relation) (*Relation, error) {
+	ret := _mock.Called(relation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRelation")
+	}
+
+	var r0 *Relation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*Relation) (*Relation, error)); ok {
+		return returnFunc(relation)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*Relation) *Relation); ok {
+		r0 = returnFunc(relation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*Relation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(*Relation) error); ok {
+		r1 = returnFunc(relation)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRelations_SaveRelation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveRelation'
+type MockRelations_SaveRelation_Call struct {
+	*mock.Call
+}
+
+// SaveRelation is a helper method to define mock.On call
+//   - relation *Relation
+func (_e *MockRelations_Expecter) SaveRelation(relation interface{}) *MockRelations_SaveRelation_Call {
+	return &MockRelations_SaveRelation_Call{Call: _e.mock.On("SaveRelation", relation)}
+}
+
+func (_c *MockRelations_SaveRelation_Call) Run(run func(relation *Relation)) *MockRelations_SaveRelation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *Relation
+		if args[0] != nil {
+			arg0 = args[0].(*Relation)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRelations_SaveRelation_Call) Return(relation1 *Relation, err error) *MockRelations_SaveRelation_Call {
+	_c.Call.Return(relation1, err)
+	return _c
+}
+
+func (_c *MockRelations_SaveRelation_Call) RunAndReturn(run func(relation *Relation) (*Relation, error)) *MockRelations_SaveRelation_Call {
+	_c.Call.Return(run)
+	return _c
+}
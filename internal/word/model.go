@@ -11,13 +11,29 @@ type Word struct {
 	Text           string             `bson:"text"`
 	EnglishMeaning string             `bson:"english_meaning"`
 	KoreanMeanings []string           `bson:"korean_meaning"`
+	PartsOfSpeech  []PartOfSpeech     `bson:"parts_of_speech"` // PartsOfSpeech[i] is the part of speech of KoreanMeanings[i]
+	Senses         []Sense            `bson:"senses"`          // the meanings of the word; the flat meaning fields are derived from them
+	IPA            string             `bson:"ipa"`
+	AudioURL       string             `bson:"audio_url"`
 	Description    string             `bson:"description"`
 	Examples       []Example          `bson:"examples,omitempty"`
 	Synonyms       []string           `bson:"synonyms"`
+	Level          Level              `bson:"level,omitempty"`
+	Tags           []string           `bson:"tags,omitempty"`
+	Status         Status             `bson:"status"`
+	Comments       []Comment          `bson:"comments,omitempty"`
+	Attachments    []Attachment       `bson:"attachments"`
 	IsDelivered    bool               `bson:"is_delivered"`
 	DeliveredAt    time.Time          `bson:"delivered_at"`
+	CreatedBy      string             `bson:"created_by"` // the editor who added the word, who may not approve it
 	CreatedAt      time.Time          `bson:"created_at"`
 	UpdatedAt      time.Time          `bson:"updated_at"`
+	DeletedAt      time.Time          `bson:"deleted_at,omitempty"` // set on soft delete, the word is kept for delivery history
+	Version        int                `bson:"version"`              // incremented on every update, to detect concurrent edits
+}
+
+func (w *Word) IsDeleted() bool {
+	return !w.DeletedAt.IsZero()
 }
 
 type Example struct {
@@ -0,0 +1,78 @@
+package word
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ApplyMergePatch returns content with a JSON Merge Patch (RFC 7396) applied. The patch uses the
+// field names of the API; fields it leaves out keep their value and fields set to null are cleared.
+// A patch that changes the flat meaning fields without touching senses rebuilds the senses from them.
+func ApplyMergePatch(content Content, patch []byte) (Content, error) {
+	var patchValue any
+	if err := json.Unmarshal(patch, &patchValue); err != nil {
+		return Content{}, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+	patchObject, ok := patchValue.(map[string]any)
+	if !ok {
+		return Content{}, fmt.Errorf("%w: patch must be a JSON object", ErrInvalidPatch)
+	}
+	if _, ok := patchObject["senses"]; !ok && patchesMeanings(patchObject) {
+		content.Senses = nil
+	}
+
+	document, err := json.Marshal(content)
+	if err != nil {
+		return Content{}, err
+	}
+	var target any
+	if err := json.Unmarshal(document, &target); err != nil {
+		return Content{}, err
+	}
+
+	merged, err := json.Marshal(mergePatch(target, patchValue))
+	if err != nil {
+		return Content{}, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	var patched Content
+	if err := decoder.Decode(&patched); err != nil {
+		return Content{}, fmt.Errorf("%w: %v", ErrInvalidPatch, err)
+	}
+
+	return patched.normalized()
+}
+
+func mergePatch(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergePatch(targetObject[key], value)
+	}
+	return targetObject
+}
+
+// meaningFields are the flat fields derived from the senses of a word.
+var meaningFields = []string{"english_meaning", "korean_meaning", "part_of_speech", "examples", "synonyms"}
+
+func patchesMeanings(patch map[string]any) bool {
+	for _, field := range meaningFields {
+		if _, ok := patch[field]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package word
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyMergePatch(t *testing.T) {
+	content := Content{
+		Text:           "test",
+		EnglishMeaning: "a trial",
+		KoreanMeanings: []string{"시험"},
+		Description:    "A way to check something.",
+		Level:          LevelB1,
+		Tags:           []string{"school"},
+	}
+
+	t.Run("Replaces given fields and keeps the rest", func(t *testing.T) {
+		patched, err := ApplyMergePatch(content, []byte(`{"english_meaning": "an exam", "korean_meaning": ["시험", "테스트"], "tags": [" Exam "]}`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "an exam", patched.EnglishMeaning)
+		assert.Equal(t, []string{"시험", "테스트"}, patched.KoreanMeanings)
+		assert.Equal(t, []string{"exam"}, patched.Tags)
+		assert.Equal(t, "A way to check something.", patched.Description)
+		assert.Equal(t, LevelB1, patched.Level)
+	})
+
+	t.Run("Null clears a field", func(t *testing.T) {
+		patched, err := ApplyMergePatch(content, []byte(`{"description": null, "level": null}`))
+
+		assert.NoError(t, err)
+		assert.Empty(t, patched.Description)
+		assert.Empty(t, patched.Level)
+	})
+
+	t.Run("Level is validated", func(t *testing.T) {
+		patched, err := ApplyMergePatch(content, []byte(`{"level": "c1"}`))
+		assert.NoError(t, err)
+		assert.Equal(t, LevelC1, patched.Level)
+
+		_, err = ApplyMergePatch(content, []byte(`{"level": "Z9"}`))
+		assert.ErrorIs(t, err, ErrInvalidLevel)
+	})
+
+	t.Run("Word is required", func(t *testing.T) {
+		_, err := ApplyMergePatch(content, []byte(`{"word": null}`))
+		assert.ErrorIs(t, err, ErrTextRequired)
+	})
+
+	t.Run("Senses win over the flat meaning fields", func(t *testing.T) {
+		patched, err := ApplyMergePatch(content, []byte(`{"senses": [{"part_of_speech": "n", "english_definition": "an exam", "korean_glosses": ["시험"], "antonyms": ["pass"]}]}`))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Sense{{PartOfSpeech: Noun, EnglishDefinition: "an exam", KoreanGlosses: []string{"시험"}, Examples: []Example{}, Synonyms: []string{}, Antonyms: []string{"pass"}}}, patched.Senses)
+		assert.Equal(t, "an exam", patched.EnglishMeaning)
+		assert.Equal(t, []PartOfSpeech{Noun}, patched.PartsOfSpeech)
+	})
+
+	t.Run("Flat meaning fields rebuild the senses", func(t *testing.T) {
+		withSenses, _ := content.normalized()
+		patched, err := ApplyMergePatch(withSenses, []byte(`{"korean_meaning": ["시험", "검사"], "part_of_speech": ["noun", "verb"]}`))
+
+		assert.NoError(t, err)
+		assert.Len(t, patched.Senses, 2)
+		assert.Equal(t, []string{"검사"}, patched.Senses[1].KoreanGlosses)
+		assert.Equal(t, "a trial", patched.Senses[0].EnglishDefinition)
+	})
+
+	t.Run("Invalid patches", func(t *testing.T) {
+		for _, patch := range []string{`not json`, `["word"]`, `{"is_delivered": false}`, `{"level": 3}`} {
+			_, err := ApplyMergePatch(content, []byte(patch))
+			assert.ErrorIs(t, err, ErrInvalidPatch, patch)
+		}
+	})
+}
@@ -0,0 +1,126 @@
+package word
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// PartOfSpeech is the grammatical role of a word in one of its meanings.
+type PartOfSpeech string
+
+const (
+	Noun         PartOfSpeech = "noun"
+	Verb         PartOfSpeech = "verb"
+	Adjective    PartOfSpeech = "adjective"
+	Adverb       PartOfSpeech = "adverb"
+	Pronoun      PartOfSpeech = "pronoun"
+	Preposition  PartOfSpeech = "preposition"
+	Conjunction  PartOfSpeech = "conjunction"
+	Interjection PartOfSpeech = "interjection"
+	Determiner   PartOfSpeech = "determiner"
+	Phrase       PartOfSpeech = "phrase"
+)
+
+var partOfSpeechLabels = map[PartOfSpeech]string{
+	Noun:         "명사",
+	Verb:         "동사",
+	Adjective:    "형용사",
+	Adverb:       "부사",
+	Pronoun:      "대명사",
+	Preposition:  "전치사",
+	Conjunction:  "접속사",
+	Interjection: "감탄사",
+	Determiner:   "한정사",
+	Phrase:       "구",
+}
+
+// partOfSpeechAbbreviations are the short forms dictionaries commonly use.
+var partOfSpeechAbbreviations = map[string]PartOfSpeech{
+	"n":    Noun,
+	"v":    Verb,
+	"adj":  Adjective,
+	"adv":  Adverb,
+	"pron": Pronoun,
+	"prep": Preposition,
+	"conj": Conjunction,
+	"int":  Interjection,
+	"det":  Determiner,
+}
+
+// Label is the Korean name of the part of speech shown to subscribers.
+func (p PartOfSpeech) Label() string {
+	return partOfSpeechLabels[p]
+}
+
+// ParsePartOfSpeech accepts a part of speech or its common abbreviation in either case; an empty value means unknown.
+func ParsePartOfSpeech(value string) (PartOfSpeech, error) {
+	value = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(value)), ".")
+	if abbreviated, ok := partOfSpeechAbbreviations[value]; ok {
+		return abbreviated, nil
+	}
+	partOfSpeech := PartOfSpeech(value)
+	if _, ok := partOfSpeechLabels[partOfSpeech]; ok || partOfSpeech == "" {
+		return partOfSpeech, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidPartOfSpeech, value)
+}
+
+// parsePartsOfSpeech checks the part of speech given for each of meanings Korean meanings, in the same order.
+func parsePartsOfSpeech(values []PartOfSpeech, meanings int) ([]PartOfSpeech, error) {
+	if len(values) > meanings {
+		return nil, fmt.Errorf("%w: %d parts of speech for %d meanings", ErrInvalidPartOfSpeech, len(values), meanings)
+	}
+
+	partsOfSpeech := make([]PartOfSpeech, 0, len(values))
+	for _, value := range values {
+		partOfSpeech, err := ParsePartOfSpeech(string(value))
+		if err != nil {
+			return nil, err
+		}
+		partsOfSpeech = append(partsOfSpeech, partOfSpeech)
+	}
+	// Trailing unknowns carry no information.
+	for len(partsOfSpeech) > 0 && partsOfSpeech[len(partsOfSpeech)-1] == "" {
+		partsOfSpeech = partsOfSpeech[:len(partsOfSpeech)-1]
+	}
+	return partsOfSpeech, nil
+}
+
+// Meaning is one Korean meaning of a word with its part of speech, if known.
+type Meaning struct {
+	PartOfSpeech PartOfSpeech
+	Text         string
+}
+
+// Meanings pairs each Korean meaning with its part of speech.
+func (w *Word) Meanings() []Meaning {
+	meanings := make([]Meaning, 0, len(w.KoreanMeanings))
+	for i, text := range w.KoreanMeanings {
+		meaning := Meaning{Text: text}
+		if i < len(w.PartsOfSpeech) {
+			meaning.PartOfSpeech = w.PartsOfSpeech[i]
+		}
+		meanings = append(meanings, meaning)
+	}
+	return meanings
+}
+
+// NormalizeIPA trims the slashes or brackets an IPA transcription is often written with; they are added back when shown.
+func NormalizeIPA(ipa string) string {
+	return strings.TrimSpace(strings.Trim(strings.TrimSpace(ipa), "/[]"))
+}
+
+// ParseAudioURL accepts an absolute http or https link to a pronunciation recording; an empty value means none.
+func ParseAudioURL(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || !slices.Contains([]string{"http", "https"}, parsed.Scheme) || parsed.Host == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidAudioURL, value)
+	}
+	return value, nil
+}
@@ -0,0 +1,79 @@
+package word
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePartOfSpeech(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    PartOfSpeech
+		wantErr bool
+	}{
+		{value: "noun", want: Noun},
+		{value: " Verb ", want: Verb},
+		{value: "adj.", want: Adjective},
+		{value: "", want: ""},
+		{value: "gerund", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParsePartOfSpeech(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidPartOfSpeech)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWord_Meanings(t *testing.T) {
+	w := &Word{KoreanMeanings: []string{"시험", "시험하다", "검사"}, PartsOfSpeech: []PartOfSpeech{Noun, Verb}}
+
+	assert.Equal(t, []Meaning{
+		{PartOfSpeech: Noun, Text: "시험"},
+		{PartOfSpeech: Verb, Text: "시험하다"},
+		{Text: "검사"},
+	}, w.Meanings())
+}
+
+func TestContent_Normalized(t *testing.T) {
+	t.Run("Pronunciation", func(t *testing.T) {
+		content, err := Content{
+			Text:           "test",
+			KoreanMeanings: []string{"시험", "시험하다"},
+			PartsOfSpeech:  []PartOfSpeech{"n", ""},
+			IPA:            " /tɛst/ ",
+			AudioURL:       "https://media.wordrop.com/audio/test.mp3",
+		}.normalized()
+
+		assert.NoError(t, err)
+		assert.Equal(t, []PartOfSpeech{Noun}, content.PartsOfSpeech, "Expected trailing unknown parts of speech to be dropped")
+		assert.Equal(t, "tɛst", content.IPA)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			content Content
+			wantErr error
+		}{
+			{name: "No Text", content: Content{Text: " "}, wantErr: ErrTextRequired},
+			{name: "More Parts Of Speech Than Meanings", content: Content{Text: "test", KoreanMeanings: []string{"시험"}, PartsOfSpeech: []PartOfSpeech{Noun, Verb}}, wantErr: ErrInvalidPartOfSpeech},
+			{name: "Relative Audio URL", content: Content{Text: "test", AudioURL: "/audio/test.mp3"}, wantErr: ErrInvalidAudioURL},
+			{name: "Script Audio URL", content: Content{Text: "test", AudioURL: "javascript:alert(1)"}, wantErr: ErrInvalidAudioURL},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := tt.content.normalized()
+				assert.ErrorIs(t, err, tt.wantErr)
+			})
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package word
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RelationType is how two words are related.
+type RelationType string
+
+const (
+	RelationSynonym     RelationType = "synonym"
+	RelationAntonym     RelationType = "antonym"
+	RelationDerived     RelationType = "derived" // the other word is a form derived from this one, as "happiness" from "happy"
+	RelationCollocation RelationType = "collocation"
+	// RelationBaseForm is a derived relation seen from the derived form. It is never stored: relating a
+	// word to its base form stores a derived relation the other way around.
+	RelationBaseForm RelationType = "base_form"
+)
+
+var relationTypes = []RelationType{RelationSynonym, RelationAntonym, RelationDerived, RelationCollocation, RelationBaseForm}
+
+func ParseRelationType(value string) (RelationType, error) {
+	relationType := RelationType(strings.ToLower(strings.TrimSpace(value)))
+	for _, known := range relationTypes {
+		if relationType == known {
+			return relationType, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidRelationType, value)
+}
+
+// Relation links two words. Only derived relations have a direction, from the base form to the derived one;
+// the others read the same from both words.
+type Relation struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Type      RelationType       `bson:"type" json:"type"`
+	FromID    primitive.ObjectID `bson:"from_id" json:"from_id"`
+	ToID      primitive.ObjectID `bson:"to_id" json:"to_id"`
+	Auto      bool               `bson:"auto" json:"auto"` // made because a synonym matched the other word, and removed when it no longer does
+	CreatedBy string             `bson:"created_by" json:"created_by,omitempty"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+}
+
+// Involves reports whether the relation links wordID to another word.
+func (r *Relation) Involves(wordID primitive.ObjectID) bool {
+	return r.FromID == wordID || r.ToID == wordID
+}
+
+// Other returns the word the relation links wordID to.
+func (r *Relation) Other(wordID primitive.ObjectID) primitive.ObjectID {
+	if r.FromID == wordID {
+		return r.ToID
+	}
+	return r.FromID
+}
+
+// TypeFrom is the type of the relation as seen from wordID.
+func (r *Relation) TypeFrom(wordID primitive.ObjectID) RelationType {
+	if r.Type == RelationDerived && r.ToID == wordID {
+		return RelationBaseForm
+	}
+	return r.Type
+}
+
+// RelatedWord is a word linked to another, as listed for that other word.
+type RelatedWord struct {
+	RelationID primitive.ObjectID `json:"relation_id"`
+	Type       RelationType       `json:"type"`
+	WordID     primitive.ObjectID `json:"word_id"`
+	Text       string             `json:"word"`
+	Auto       bool               `json:"auto"`
+}
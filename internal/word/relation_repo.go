@@ -0,0 +1,70 @@
+package word
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const relationCollectionName = "word_relations"
+
+// RelationMongoRepository stores the links between words, one document per pair of words and type.
+type RelationMongoRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRelationRepo(db *mongo.Database) *RelationMongoRepository {
+	return &RelationMongoRepository{
+		collection: db.Collection(relationCollectionName),
+	}
+}
+
+func (r *RelationMongoRepository) SaveRelation(relation *Relation) (*Relation, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := r.collection.InsertOne(ctx, relation)
+	if err != nil {
+		return nil, err
+	}
+
+	relation.ID = result.InsertedID.(primitive.ObjectID)
+	return relation, nil
+}
+
+// FindRelations returns every relation of the word, whichever end of it the word is, oldest first.
+func (r *RelationMongoRepository) FindRelations(wordID primitive.ObjectID) ([]*Relation, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{"$or": bson.A{bson.M{"from_id": wordID}, bson.M{"to_id": wordID}}}
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	relations := []*Relation{}
+	if err := cursor.All(ctx, &relations); err != nil {
+		return nil, err
+	}
+	return relations, nil
+}
+
+func (r *RelationMongoRepository) DeleteRelation(id primitive.ObjectID) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return ErrRelationNotFound
+	}
+	return nil
+}
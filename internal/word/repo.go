@@ -2,8 +2,10 @@ package word
 
 import (
 	"context"
-	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/Go-roro/wordrop/internal/common"
@@ -25,6 +27,39 @@ func NewWordRepo(db *mongo.Database) *MongoRepository {
 	}
 }
 
+// listingIndexes are the orders words can be listed in, each served by an index made in EnsureIndexes: one per
+// sort field, and delivered_at with text for the archive listed by delivery and then alphabetically. They end in
+// _id as every listing does, and each also serves the exact opposite order.
+var listingIndexes = []sortKeys{
+	{{Field: "created_at", Order: 1}, {Field: "_id", Order: 1}},
+	{{Field: "updated_at", Order: 1}, {Field: "_id", Order: 1}},
+	{{Field: "delivered_at", Order: 1}, {Field: "_id", Order: 1}},
+	{{Field: "level", Order: 1}, {Field: "_id", Order: 1}},
+	{{Field: "text", Order: 1}, {Field: "_id", Order: 1}},
+	{{Field: "delivered_at", Order: -1}, {Field: "text", Order: 1}, {Field: "_id", Order: 1}},
+}
+
+// EnsureIndexes creates the listingIndexes, if they do not exist yet. Indexes on text use textCollation,
+// the only collation that sorts by it.
+func (r *MongoRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	indexes := make([]mongo.IndexModel, 0, len(listingIndexes))
+	for _, keys := range listingIndexes {
+		index := mongo.IndexModel{Keys: keys.sort()}
+		if keys.collated() {
+			index.Options = options.Index().SetCollation(textCollation)
+		}
+		indexes = append(indexes, index)
+	}
+	if _, err := r.collection.Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Printf("Failed to create word indexes: %v", err)
+		return err
+	}
+	return nil
+}
+
 func (r *MongoRepository) SaveWord(word *Word) (*Word, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -32,6 +67,7 @@ func (r *MongoRepository) SaveWord(word *Word) (*Word, error) {
 	now := time.Now()
 	word.CreatedAt = now
 	word.UpdatedAt = now
+	word.Version = 1
 
 	result, err := r.collection.InsertOne(ctx, word)
 	if err != nil {
@@ -63,28 +99,268 @@ func (r *MongoRepository) FindById(id string) (*Word, error) {
 	return findWord, nil
 }
 
+// UpdateWord replaces the stored word and bumps its version. It returns ErrVersionConflict if the
+// stored word is no longer at word.Version, i.e. someone else updated it since it was read.
 func (r *MongoRepository) UpdateWord(word *Word) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	target := bson.M{"_id": word.ID}
-	update := bson.M{"$set": word}
+	target := bson.M{"_id": word.ID, "version": word.Version}
+	if word.Version == 0 {
+		// Words saved before versioning have no version field.
+		target["version"] = bson.M{"$in": bson.A{0, nil}}
+	}
+	// The whole document is replaced, so fields cleared on word, which omitempty leaves out, are removed too.
+	updated := *word
+	updated.Version++
 
-	_, err := r.collection.UpdateOne(ctx, target, update)
+	result, err := r.collection.ReplaceOne(ctx, target, &updated)
 	if err != nil {
 		log.Printf("Word with ID: %s failed to update", word.ID)
 		return err
 	}
+	if result.MatchedCount == 0 {
+		return ErrVersionConflict
+	}
 
+	word.Version = updated.Version
 	return nil
 }
 
+// FindLatestDelivered returns the most recently delivered word, or mongo.ErrNoDocuments if none was delivered yet.
+func (r *MongoRepository) FindLatestDelivered() (*Word, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	findOptions := options.FindOne().SetSort(bson.D{{Key: "delivered_at", Value: -1}})
+	result := r.collection.FindOne(ctx, notDeleted(bson.M{"is_delivered": true}), findOptions)
+	latestWord := &Word{}
+	if err := result.Decode(latestWord); err != nil {
+		return nil, err
+	}
+
+	return latestWord, nil
+}
+
+// FindDeliveredByText returns the delivered word spelled text, ignoring case, or mongo.ErrNoDocuments if there is none.
+func (r *MongoRepository) FindDeliveredByText(text string) (*Word, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	findOptions := options.FindOne().SetCollation(&options.Collation{Locale: "en", Strength: 2})
+	result := r.collection.FindOne(ctx, notDeleted(bson.M{"text": text, "is_delivered": true}), findOptions)
+	deliveredWord := &Word{}
+	if err := result.Decode(deliveredWord); err != nil {
+		return nil, err
+	}
+
+	return deliveredWord, nil
+}
+
+// FindByTexts returns the words, not deleted, whose text is one of texts, ignoring case.
+func (r *MongoRepository) FindByTexts(texts []string) ([]*Word, error) {
+	return r.findCaseInsensitive(bson.M{"text": bson.M{"$in": texts}})
+}
+
+// FindBySynonym returns the words, not deleted, that list text among their synonyms, ignoring case.
+func (r *MongoRepository) FindBySynonym(text string) ([]*Word, error) {
+	return r.findCaseInsensitive(bson.M{"synonyms": text})
+}
+
+// FindByIds returns the words with the given IDs that are not deleted, in no particular order.
+func (r *MongoRepository) FindByIds(ids []primitive.ObjectID) ([]*Word, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	cursor, err := r.collection.Find(ctx, notDeleted(bson.M{"_id": bson.M{"$in": ids}}))
+	if err != nil {
+		return nil, err
+	}
+
+	words := []*Word{}
+	if err := cursor.All(ctx, &words); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+func (r *MongoRepository) findCaseInsensitive(filter bson.M) ([]*Word, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	findOptions := options.Find().SetCollation(&options.Collation{Locale: "en", Strength: 2})
+	cursor, err := r.collection.Find(ctx, notDeleted(filter), findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	words := []*Word{}
+	if err := cursor.All(ctx, &words); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+// MigrateStatuses gives words saved before the editorial workflow a status: delivered if they were sent,
+// approved otherwise, since they were all eligible for delivery. It returns the number of words migrated.
+func (r *MongoRepository) MigrateStatuses() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var migrated int64
+	for _, delivered := range []bool{true, false} {
+		status := StatusApproved
+		if delivered {
+			status = StatusDelivered
+		}
+		filter := bson.M{"status": bson.M{"$exists": false}, "is_delivered": delivered}
+		result, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"status": status}})
+		if err != nil {
+			return migrated, err
+		}
+		migrated += result.ModifiedCount
+	}
+	return migrated, nil
+}
+
+// MigrateSenses gives words saved before senses their senses, built from the flat meaning fields.
+// The flat fields are left as they are. It returns the number of words migrated.
+func (r *MongoRepository) MigrateSenses() (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	filter := bson.M{"senses": bson.M{"$exists": false}}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var migrated int64
+	for cursor.Next(ctx) {
+		var word Word
+		if err := cursor.Decode(&word); err != nil {
+			return migrated, err
+		}
+		senses := SensesFromMeanings(word.EnglishMeaning, word.KoreanMeanings, word.PartsOfSpeech, word.Examples, word.Synonyms)
+		if senses == nil {
+			senses = []Sense{}
+		}
+		result, err := r.collection.UpdateOne(ctx,
+			bson.M{"_id": word.ID, "senses": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"senses": senses}})
+		if err != nil {
+			return migrated, err
+		}
+		migrated += result.ModifiedCount
+	}
+	return migrated, cursor.Err()
+}
+
+// Selection narrows the words delivered to a subscriber. Empty Levels or Tags match every word.
+type Selection struct {
+	ExcludeIDs []primitive.ObjectID
+	Levels     []Level
+	Tags       []string
+}
+
+// Matches reports whether w is a word the selection allows.
+func (s *Selection) Matches(w *Word) bool {
+	if w.IsDeleted() || !w.Status.Deliverable() || slices.Contains(s.ExcludeIDs, w.ID) {
+		return false
+	}
+	if len(s.Levels) > 0 && !slices.Contains(s.Levels, w.Level) {
+		return false
+	}
+	if len(s.Tags) > 0 && !slices.ContainsFunc(w.Tags, func(tag string) bool { return slices.Contains(s.Tags, tag) }) {
+		return false
+	}
+	return true
+}
+
+// FindNextWord returns the oldest deliverable word matching selection, or mongo.ErrNoDocuments if there is none.
+func (r *MongoRepository) FindNextWord(selection *Selection) (*Word, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := notDeleted(bson.M{"status": bson.M{"$in": deliverableStatuses}})
+	if len(selection.ExcludeIDs) > 0 {
+		filter["_id"] = bson.M{"$nin": selection.ExcludeIDs}
+	}
+	addLevelAndTagFilter(filter, selection.Levels, selection.Tags)
+
+	findOptions := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}})
+	nextWord := &Word{}
+	if err := r.collection.FindOne(ctx, filter, findOptions).Decode(nextWord); err != nil {
+		return nil, err
+	}
+
+	return nextWord, nil
+}
+
+// FindUndeliveredWords returns up to limit approved words nobody has received yet, oldest first, skipping excludeIDs.
+func (r *MongoRepository) FindUndeliveredWords(excludeIDs []primitive.ObjectID, limit int) ([]*Word, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := notDeleted(bson.M{"is_delivered": false, "status": StatusApproved, "_id": bson.M{"$nin": excludeIDs}})
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var words []*Word
+	if err := cursor.All(ctx, &words); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+// SampleWords returns up to size random deliverable words whose IDs are not in excludeIDs.
+func (r *MongoRepository) SampleWords(size int, excludeIDs []primitive.ObjectID) ([]*Word, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: notDeleted(bson.M{"_id": bson.M{"$nin": excludeIDs}, "status": bson.M{"$in": deliverableStatuses}})}},
+		{{Key: "$sample", Value: bson.M{"size": size}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var words []*Word
+	if err := cursor.All(ctx, &words); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
 type SearchParams struct {
 	IsDelivered *bool  `json:"is_delivered"`
 	Page        int    `json:"page"`
 	PageSize    int    `json:"page_size"`
 	SortBy      string `json:"sort_by"`
 	SortOrder   string `json:"sort_order"`
+	// Sort orders by several fields, e.g. "delivered_at:desc,text:asc", and takes the place of SortBy and
+	// SortOrder when set. A field without an order is sorted in the default order.
+	Sort string `json:"sort"`
+	// Levels and Tags match words with any of the given levels and any of the given tags.
+	Levels []Level  `json:"levels"`
+	Tags   []string `json:"tags"`
+	// Statuses matches words in any of the given statuses.
+	Statuses []Status `json:"statuses"`
+	// UseCursor lists words by cursor instead of page number: each page continues after Cursor, the
+	// NextCursor of the page before, or starts from the top when it is empty. Page is then ignored.
+	UseCursor bool   `json:"use_cursor"`
+	Cursor    string `json:"cursor"`
+	// WithTotal counts the matching words along with a cursor page, which takes a query of its own.
+	// Pages by number are always counted.
+	WithTotal bool `json:"with_total"`
 }
 
 const defaultSortBy = "created_at"
@@ -92,10 +368,20 @@ const defaultSortOrder = "desc"
 const defaultPageSize = 10
 const maxPageSize = 30
 
+// allowedSortFields are the fields words can be sorted by, each served by one of the listingIndexes.
+// Levels sort by name, which is also their order from A1 to C2.
 var allowedSortFields = map[string]bool{
-	"created_at": true,
+	"created_at":   true,
+	"updated_at":   true,
+	"delivered_at": true,
+	"text":         true,
+	"level":        true,
 }
 
+// textCollation orders words by text the way a dictionary does: Korean words by Hangul and English words
+// alphabetically before them, with case only telling apart words that are otherwise the same.
+var textCollation = &options.Collation{Locale: "ko"}
+
 var allowedSortOrders = map[string]int{
 	"asc":  1,
 	"desc": -1,
@@ -106,10 +392,15 @@ func (r *MongoRepository) FindWords(params *SearchParams) (*common.PageResult[*W
 	defer cancel()
 
 	filter := setupFilter(params)
-	findOptions, err := setupOptions(params)
+	keys, err := setupSort(params)
 	if err != nil {
 		return nil, err
 	}
+	findOptions := options.Find().SetSort(keys.sort())
+	if keys.collated() {
+		// The cursor filter compares text too, so it has to agree with the sort.
+		findOptions.SetCollation(textCollation)
+	}
 
 	pageSize := defaultPageSize
 	if params.PageSize > 0 {
@@ -121,6 +412,10 @@ func (r *MongoRepository) FindWords(params *SearchParams) (*common.PageResult[*W
 		pageSize = maxPageSize
 	}
 
+	if params.UseCursor || params.Cursor != "" {
+		return r.findWordsAfter(ctx, filter, keys, findOptions, params, pageSize)
+	}
+
 	page := 1
 	if params.Page > 0 {
 		page = params.Page
@@ -152,16 +447,99 @@ func (r *MongoRepository) FindWords(params *SearchParams) (*common.PageResult[*W
 	return common.NewPageResult(words, page, int64(pageSize), total), nil
 }
 
+// findWordsAfter lists the page of words following params.Cursor. Unlike skipping pages, it seeks straight
+// to the cursor through the sort index and does not shift when words are added in the meantime.
+func (r *MongoRepository) findWordsAfter(ctx context.Context, filter bson.M, keys sortKeys, findOptions *options.FindOptions, params *SearchParams, pageSize int) (*common.PageResult[*Word], error) {
+	pageFilter := filter
+	if params.Cursor != "" {
+		cursor, err := decodeCursor(keys, params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		pageFilter = bson.M{"$and": bson.A{filter, cursor.after(keys)}}
+	}
+	// One word more than the page tells whether there is a next page.
+	findOptions.SetLimit(int64(pageSize + 1))
+
+	results, err := r.collection.Find(ctx, pageFilter, findOptions)
+	if err != nil {
+		log.Printf("Failed to find words with filter: %v, error: %v", pageFilter, err)
+		return nil, err
+	}
+	defer results.Close(ctx)
+
+	words := []*Word{}
+	var last bson.Raw
+	for len(words) < pageSize && results.Next(ctx) {
+		var word Word
+		if err := results.Decode(&word); err != nil {
+			log.Printf("Failed to decode word: %v", err)
+			return nil, err
+		}
+		words = append(words, &word)
+		last = slices.Clone(results.Current)
+	}
+
+	var nextCursor string
+	if results.Next(ctx) {
+		if nextCursor, err = encodeCursor(keys, last); err != nil {
+			return nil, err
+		}
+	}
+	if err := results.Err(); err != nil {
+		return nil, err
+	}
+
+	var total *int64
+	if params.WithTotal {
+		count, err := r.collection.CountDocuments(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		total = &count
+	}
+	return common.NewCursorResult(words, nextCursor, total), nil
+}
+
 func setupFilter(params *SearchParams) bson.M {
-	filter := bson.M{}
+	filter := notDeleted(bson.M{})
 	if deliveredFilter := params.IsDelivered; deliveredFilter != nil {
 		filter["is_delivered"] = *deliveredFilter
 	}
+	addLevelAndTagFilter(filter, params.Levels, params.Tags)
+	if len(params.Statuses) > 0 {
+		filter["status"] = bson.M{"$in": params.Statuses}
+	}
 	return filter
 }
 
-func setupOptions(params *SearchParams) (*options.FindOptions, error) {
-	findOptions := options.Find()
+// notDeleted narrows filter to words that have not been soft-deleted.
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$exists": false}
+	return filter
+}
+
+func addLevelAndTagFilter(filter bson.M, levels []Level, tags []string) {
+	if len(levels) > 0 {
+		filter["level"] = bson.M{"$in": levels}
+	}
+	if len(tags) > 0 {
+		filter["tags"] = bson.M{"$in": tags}
+	}
+}
+
+func setupSort(params *SearchParams) (sortKeys, error) {
+	if params.Sort != "" {
+		keys, err := parseSort(params.Sort)
+		if err != nil {
+			return nil, err
+		}
+		keys = keys.withTieBreaker()
+		if !slices.ContainsFunc(listingIndexes, keys.servedBy) {
+			return nil, fmt.Errorf("%w: no index lists words by %s", ErrInvalidSort, params.Sort)
+		}
+		return keys, nil
+	}
 
 	sortField, err := parseSortBy(params.SortBy)
 	if err != nil {
@@ -173,8 +551,31 @@ func setupOptions(params *SearchParams) (*options.FindOptions, error) {
 		return nil, err
 	}
 
-	findOptions.SetSort(bson.D{{sortField, sortOder}})
-	return findOptions, nil
+	return sortKeys{{Field: sortField, Order: sortOder}}.withTieBreaker(), nil
+}
+
+// parseSort reads a sort of comma separated fields, each optionally followed by ":asc" or ":desc".
+func parseSort(sort string) (sortKeys, error) {
+	var keys sortKeys
+	for _, part := range strings.Split(sort, ",") {
+		field, order, _ := strings.Cut(strings.TrimSpace(part), ":")
+		if field == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSort, sort)
+		}
+		field, err := parseSortBy(field)
+		if err != nil {
+			return nil, err
+		}
+		if slices.ContainsFunc(keys, func(key sortKey) bool { return key.Field == field }) {
+			return nil, fmt.Errorf("%w: %s is sorted by twice", ErrInvalidSort, field)
+		}
+		direction, err := parseSortOrder(order)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, sortKey{Field: field, Order: direction})
+	}
+	return keys, nil
 }
 
 func parseSortBy(sortedBy string) (string, error) {
@@ -184,7 +585,7 @@ func parseSortBy(sortedBy string) (string, error) {
 
 	if _, ok := allowedSortFields[sortedBy]; !ok {
 		log.Printf("Invalid sort field: %s", sortedBy)
-		return "", errors.New("invalid sort field")
+		return "", fmt.Errorf("%w: unknown field %q", ErrInvalidSort, sortedBy)
 	}
 	return sortedBy, nil
 }
@@ -196,7 +597,7 @@ func parseSortOrder(sortOrder string) (int, error) {
 
 	if _, ok := allowedSortOrders[sortOrder]; !ok {
 		log.Printf("Invalid sort order: %s", sortOrder)
-		return 0, errors.New("invalid sort order")
+		return 0, fmt.Errorf("%w: unknown order %q", ErrInvalidSort, sortOrder)
 	}
 
 	return allowedSortOrders[sortOrder], nil
@@ -1,12 +1,17 @@
 package word
 
 import (
+	"context"
 	"log"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/Go-roro/wordrop/internal/infra/testhelper"
 	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 type WordRepoTestSuite struct {
@@ -54,6 +59,7 @@ func wordFixture() *Word {
 			},
 		},
 		Synonyms:    []string{"exam", "assessment"},
+		Status:      StatusApproved,
 		IsDelivered: false,
 	}
 }
@@ -98,6 +104,46 @@ func (suite *WordRepoTestSuite) TestWordRepository_UpdateWord() {
 	})
 }
 
+func (suite *WordRepoTestSuite) TestWordRepository_UpdateWordVersion() {
+	suite.Run("Rejects a stale version", func() {
+		savedWord, _ := suite.repo.SaveWord(wordFixture())
+		suite.Equal(1, savedWord.Version)
+
+		stale := *savedWord
+		savedWord.Text = "first edit"
+		suite.NoError(suite.repo.UpdateWord(savedWord), "Expected no error when updating the current version")
+		suite.Equal(2, savedWord.Version)
+
+		stale.Text = "second edit"
+		suite.ErrorIs(suite.repo.UpdateWord(&stale), ErrVersionConflict)
+
+		findById, _ := suite.repo.FindById(savedWord.ID.Hex())
+		suite.Equal("first edit", findById.Text, "Expected the stale update to be rejected")
+		suite.Equal(2, findById.Version)
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_UpdateWordClearsFields() {
+	suite.Run("Level, tags and examples", func() {
+		word := wordFixture()
+		word.Level = LevelB2
+		word.Tags = []string{"business"}
+		savedWord, _ := suite.repo.SaveWord(word)
+
+		savedWord.Level = ""
+		savedWord.Tags = []string{}
+		savedWord.Examples = nil
+		suite.NoError(suite.repo.UpdateWord(savedWord), "Expected no error when clearing fields")
+
+		findById, err := suite.repo.FindById(savedWord.ID.Hex())
+		suite.NoError(err, "Expected no error when finding word by ID")
+		suite.Empty(findById.Level, "Expected the level to be cleared")
+		suite.Empty(findById.Tags, "Expected the tags to be cleared")
+		suite.Empty(findById.Examples, "Expected the examples to be cleared")
+		suite.Equal(savedWord.Text, findById.Text, "Expected the other fields to be kept")
+	})
+}
+
 func (suite *WordRepoTestSuite) TestFindWords_Basic() {
 	suite.Run("Basic FindWords", func() {
 		_, _ = suite.repo.SaveWord(wordFixture())
@@ -111,6 +157,226 @@ func (suite *WordRepoTestSuite) TestFindWords_Basic() {
 	})
 }
 
+func (suite *WordRepoTestSuite) TestWordRepository_FindLatestDelivered() {
+	suite.Run("Latest", func() {
+		older := wordFixture()
+		older.IsDelivered = true
+		older.DeliveredAt = time.Now().Add(-24 * time.Hour)
+		_, _ = suite.repo.SaveWord(older)
+
+		latest := wordFixture()
+		latest.Text = "latest"
+		latest.IsDelivered = true
+		latest.DeliveredAt = time.Now()
+		_, _ = suite.repo.SaveWord(latest)
+		_, _ = suite.repo.SaveWord(wordFixture())
+
+		found, err := suite.repo.FindLatestDelivered()
+		suite.NoError(err, "Expected no error when finding latest delivered word")
+		suite.Equal("latest", found.Text, "Expected the most recently delivered word")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_FindDeliveredByText() {
+	suite.Run("Only delivered words, ignoring case", func() {
+		delivered := wordFixture()
+		delivered.Text = "Serendipity"
+		delivered.IsDelivered = true
+		_, _ = suite.repo.SaveWord(delivered)
+
+		pending := wordFixture()
+		pending.Text = "ephemeral"
+		_, _ = suite.repo.SaveWord(pending)
+
+		found, err := suite.repo.FindDeliveredByText("serendipity")
+		suite.NoError(err, "Expected no error when finding a delivered word by text")
+		suite.Equal(delivered.ID, found.ID)
+
+		_, err = suite.repo.FindDeliveredByText("ephemeral")
+		suite.ErrorIs(err, mongo.ErrNoDocuments, "Expected words that were not delivered to stay hidden")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_FindNextWord() {
+	suite.Run("Skips excluded words", func() {
+		first, _ := suite.repo.SaveWord(wordFixture())
+		second := wordFixture()
+		second.Text = "second"
+		_, _ = suite.repo.SaveWord(second)
+
+		next, err := suite.repo.FindNextWord(&Selection{})
+		suite.NoError(err, "Expected no error when finding next word")
+		suite.Equal(first.ID, next.ID, "Expected the oldest word first")
+
+		next, err = suite.repo.FindNextWord(&Selection{ExcludeIDs: []primitive.ObjectID{first.ID}})
+		suite.NoError(err, "Expected no error when finding next word")
+		suite.Equal("second", next.Text, "Expected excluded words to be skipped")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_FindNextWordOnlyApproved() {
+	suite.Run("Skips words that were not approved", func() {
+		draft := wordFixture()
+		draft.Status = StatusDraft
+		_, _ = suite.repo.SaveWord(draft)
+		inReview := wordFixture()
+		inReview.Status = StatusInReview
+		_, _ = suite.repo.SaveWord(inReview)
+		approved := wordFixture()
+		approved.Text = "approved"
+		_, _ = suite.repo.SaveWord(approved)
+
+		next, err := suite.repo.FindNextWord(&Selection{})
+		suite.NoError(err, "Expected no error when finding next word")
+		suite.Equal("approved", next.Text, "Expected only approved words to be delivered")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_MigrateStatuses() {
+	suite.Run("Words without a status", func() {
+		delivered := wordFixture()
+		delivered.IsDelivered = true
+		_, _ = suite.repo.SaveWord(delivered)
+		pending, _ := suite.repo.SaveWord(wordFixture())
+		_, err := suite.repo.collection.UpdateMany(context.Background(), bson.M{}, bson.M{"$unset": bson.M{"status": ""}})
+		suite.Require().NoError(err)
+
+		migrated, err := suite.repo.MigrateStatuses()
+		suite.NoError(err, "Expected no error when migrating statuses")
+		suite.Equal(int64(2), migrated)
+
+		found, _ := suite.repo.FindById(delivered.ID.Hex())
+		suite.Equal(StatusDelivered, found.Status)
+		found, _ = suite.repo.FindById(pending.ID.Hex())
+		suite.Equal(StatusApproved, found.Status)
+
+		migrated, _ = suite.repo.MigrateStatuses()
+		suite.Zero(migrated, "Expected a second run to change nothing")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_MigrateSenses() {
+	suite.Run("Words without senses", func() {
+		legacy := wordFixture()
+		legacy.KoreanMeanings = []string{"시험", "시험하다"}
+		legacy.PartsOfSpeech = []PartOfSpeech{Noun, Verb}
+		_, _ = suite.repo.SaveWord(legacy)
+		_, err := suite.repo.collection.UpdateMany(context.Background(), bson.M{}, bson.M{"$unset": bson.M{"senses": ""}})
+		suite.Require().NoError(err)
+
+		migrated, err := suite.repo.MigrateSenses()
+		suite.NoError(err, "Expected no error when migrating senses")
+		suite.Equal(int64(1), migrated)
+
+		found, _ := suite.repo.FindById(legacy.ID.Hex())
+		suite.Len(found.Senses, 2, "Expected a sense per part of speech")
+		suite.Equal([]string{"시험하다"}, found.Senses[1].KoreanGlosses)
+		suite.Equal(legacy.KoreanMeanings, found.KoreanMeanings, "Expected the flat fields to be kept")
+
+		migrated, _ = suite.repo.MigrateSenses()
+		suite.Zero(migrated, "Expected a second run to change nothing")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_FindNextWordByLevelAndTags() {
+	suite.Run("Only matching words", func() {
+		beginner := wordFixture()
+		beginner.Level = LevelA1
+		beginner.Tags = []string{"food"}
+		_, _ = suite.repo.SaveWord(beginner)
+
+		advanced := wordFixture()
+		advanced.Text = "advanced"
+		advanced.Level = LevelC1
+		advanced.Tags = []string{"business", "travel"}
+		_, _ = suite.repo.SaveWord(advanced)
+
+		next, err := suite.repo.FindNextWord(&Selection{Levels: []Level{LevelB2, LevelC1}, Tags: []string{"travel"}})
+		suite.NoError(err, "Expected no error when finding next word")
+		suite.Equal("advanced", next.Text, "Expected only words of the selected level and tags")
+
+		_, err = suite.repo.FindNextWord(&Selection{Levels: []Level{LevelA1}, Tags: []string{"travel"}})
+		suite.Error(err, "Expected no word to match both level and tags")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestFindWordsWithLevelAndTagFilter() {
+	suite.Run("FindWords with level and tag filter", func() {
+		tagged := wordFixture()
+		tagged.Level = LevelB1
+		tagged.Tags = []string{"travel"}
+		_, _ = suite.repo.SaveWord(tagged)
+		_, _ = suite.repo.SaveWord(wordFixture())
+
+		words, err := suite.repo.FindWords(&SearchParams{Levels: []Level{LevelB1}, Tags: []string{"travel", "food"}})
+		suite.NoError(err, "Expected no error when finding words with level and tag filter")
+		suite.Equal(1, len(words.Data), "Expected to find 1 word with level B1 tagged travel")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_SampleWords() {
+	suite.Run("Excludes given words", func() {
+		excluded, _ := suite.repo.SaveWord(wordFixture())
+		for i := 0; i < 3; i++ {
+			_, _ = suite.repo.SaveWord(wordFixture())
+		}
+
+		words, err := suite.repo.SampleWords(10, []primitive.ObjectID{excluded.ID})
+		suite.NoError(err, "Expected no error when sampling words")
+		suite.Len(words, 3)
+		for _, word := range words {
+			suite.NotEqual(excluded.ID, word.ID)
+		}
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_FindUndeliveredWords() {
+	suite.Run("Oldest undelivered words that are not deleted or excluded", func() {
+		first, _ := suite.repo.SaveWord(wordFixture())
+		excluded, _ := suite.repo.SaveWord(wordFixture())
+		deleted := wordFixture()
+		deleted.DeletedAt = time.Now()
+		_, _ = suite.repo.SaveWord(deleted)
+		delivered := wordFixture()
+		delivered.IsDelivered = true
+		_, _ = suite.repo.SaveWord(delivered)
+		last, _ := suite.repo.SaveWord(wordFixture())
+
+		words, err := suite.repo.FindUndeliveredWords([]primitive.ObjectID{excluded.ID}, 5)
+		suite.NoError(err, "Expected no error when finding undelivered words")
+		suite.Require().Len(words, 2)
+		suite.Equal(first.ID, words[0].ID)
+		suite.Equal(last.ID, words[1].ID)
+	})
+}
+
+func (suite *WordRepoTestSuite) TestFindWordsWithStatusFilter() {
+	suite.Run("FindWords with status filter", func() {
+		draft := wordFixture()
+		draft.Status = StatusDraft
+		_, _ = suite.repo.SaveWord(draft)
+		_, _ = suite.repo.SaveWord(wordFixture())
+
+		words, err := suite.repo.FindWords(&SearchParams{Statuses: []Status{StatusDraft, StatusInReview}})
+		suite.NoError(err, "Expected no error when finding words with status filter")
+		suite.Require().Len(words.Data, 1)
+		suite.Equal(StatusDraft, words.Data[0].Status)
+	})
+}
+
+func (suite *WordRepoTestSuite) TestFindWordsHidesDeletedWords() {
+	suite.Run("Deleted words are not listed", func() {
+		deleted := wordFixture()
+		deleted.DeletedAt = time.Now()
+		_, _ = suite.repo.SaveWord(deleted)
+		_, _ = suite.repo.SaveWord(wordFixture())
+
+		result, err := suite.repo.FindWords(&SearchParams{})
+		suite.NoError(err, "Expected no error when finding words")
+		suite.Equal(int64(1), *result.TotalSize)
+	})
+}
+
 func (suite *WordRepoTestSuite) TestFindWordsWithIsDeliveredFilter() {
 	suite.Run("FindWords with is_delivered filter", func() {
 		wordA := wordFixture()
@@ -146,6 +412,194 @@ func (suite *WordRepoTestSuite) TestFindWordsWithPagination() {
 		suite.Equal(pageSize, len(words.Data))
 		suite.Equal(page, words.Page)
 		suite.Equal(3, words.LastPage)
-		suite.Equal(int64(5), words.TotalSize)
+		suite.Equal(int64(5), *words.TotalSize)
+	})
+}
+
+func (suite *WordRepoTestSuite) TestFindWordsWithCursor() {
+	suite.Run("Every word once, across pages", func() {
+		for i := 0; i < 5; i++ {
+			w := wordFixture()
+			w.Text = "test" + strconv.Itoa(i)
+			_, _ = suite.repo.SaveWord(w)
+		}
+
+		var texts []string
+		params := &SearchParams{UseCursor: true, PageSize: 2, WithTotal: true}
+		for pages := 0; pages < 5; pages++ {
+			words, err := suite.repo.FindWords(params)
+			suite.Require().NoError(err, "Expected no error when finding words by cursor")
+			suite.Equal(int64(5), *words.TotalSize)
+			for _, w := range words.Data {
+				texts = append(texts, w.Text)
+			}
+			if words.NextCursor == "" {
+				break
+			}
+			params.Cursor = words.NextCursor
+		}
+		suite.Equal([]string{"test4", "test3", "test2", "test1", "test0"}, texts, "Expected the newest words first")
+
+		_, err := suite.repo.FindWords(&SearchParams{Cursor: params.Cursor, SortBy: "delivered_at"})
+		suite.ErrorIs(err, ErrInvalidCursor, "Expected a cursor not to work for another order")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestFindWordsWithMultiKeySort() {
+	suite.Run("By delivery, then alphabetically across pages", func() {
+		suite.Require().NoError(suite.repo.EnsureIndexes(), "Expected no error when creating indexes")
+
+		monday := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+		for text, deliveredAt := range map[string]time.Time{
+			"banana": monday,
+			"Apple":  monday,
+			"사과":     monday,
+			"cherry": monday.AddDate(0, 0, 7),
+		} {
+			w := wordFixture()
+			w.Text = text
+			w.IsDelivered = true
+			w.DeliveredAt = deliveredAt
+			_, _ = suite.repo.SaveWord(w)
+		}
+
+		var texts []string
+		isDelivered := true
+		params := &SearchParams{IsDelivered: &isDelivered, Sort: "delivered_at:desc,text:asc", UseCursor: true, PageSize: 2}
+		for pages := 0; pages < 4; pages++ {
+			words, err := suite.repo.FindWords(params)
+			suite.Require().NoError(err, "Expected no error when finding words sorted by several fields")
+			for _, w := range words.Data {
+				texts = append(texts, w.Text)
+			}
+			if words.NextCursor == "" {
+				break
+			}
+			params.Cursor = words.NextCursor
+		}
+		suite.Equal([]string{"cherry", "Apple", "banana", "사과"}, texts, "Expected the latest delivery first, then English before Korean regardless of case")
+
+		_, err := suite.repo.FindWords(&SearchParams{Sort: "text:sideways"})
+		suite.ErrorIs(err, ErrInvalidSort)
+	})
+}
+
+func (suite *WordRepoTestSuite) TestFindWordsWithCursorAcrossMissingLevels() {
+	suite.Run("Words without a level do not hide the rest", func() {
+		for i, level := range []Level{"", "", LevelB1, LevelA1, ""} {
+			w := wordFixture()
+			w.Text = "test" + strconv.Itoa(i)
+			w.Level = level
+			_, _ = suite.repo.SaveWord(w)
+		}
+
+		for _, sort := range []string{"level:asc", "level:desc"} {
+			var levels []Level
+			params := &SearchParams{Sort: sort, UseCursor: true, PageSize: 2}
+			for pages := 0; pages < 5; pages++ {
+				words, err := suite.repo.FindWords(params)
+				suite.Require().NoError(err, "Expected no error when finding words by level")
+				for _, w := range words.Data {
+					levels = append(levels, w.Level)
+				}
+				if words.NextCursor == "" {
+					break
+				}
+				params.Cursor = words.NextCursor
+			}
+			want := []Level{"", "", "", LevelA1, LevelB1}
+			if sort == "level:desc" {
+				want = []Level{LevelB1, LevelA1, "", "", ""}
+			}
+			suite.Equal(want, levels, "Expected every word once sorted by %s", sort)
+		}
+	})
+}
+
+func (suite *WordRepoTestSuite) TestRevisionRepository() {
+	suite.Run("Newest revision first", func() {
+		revisions := NewRevisionRepo(suite.database.DbInstance)
+		wordID := primitive.NewObjectID()
+		for number := 1; number <= 3; number++ {
+			_, err := revisions.SaveRevision(&Revision{WordID: wordID, Number: number, Action: RevisionUpdated, CreatedAt: time.Now()})
+			suite.NoError(err, "Expected no error when saving a revision")
+		}
+		_, _ = revisions.SaveRevision(&Revision{WordID: primitive.NewObjectID(), Number: 7})
+
+		found, err := revisions.FindRevisions(wordID)
+		suite.NoError(err, "Expected no error when finding revisions")
+		suite.Require().Len(found, 3)
+		suite.Equal(3, found[0].Number)
+
+		latest, err := revisions.FindLatestRevision(wordID)
+		suite.NoError(err, "Expected no error when finding the latest revision")
+		suite.Equal(3, latest.Number)
+
+		second, err := revisions.FindRevision(wordID, 2)
+		suite.NoError(err, "Expected no error when finding a revision")
+		suite.Equal(2, second.Number)
+
+		_, err = revisions.FindRevision(wordID, 4)
+		suite.ErrorIs(err, ErrRevisionNotFound)
+	})
+}
+
+func (suite *WordRepoTestSuite) TestRevisionRepository_UniqueNumbers() {
+	suite.Run("A number is taken once per word", func() {
+		revisions := NewRevisionRepo(suite.database.DbInstance)
+		suite.Require().NoError(revisions.EnsureIndexes(), "Expected no error when creating indexes")
+		wordID := primitive.NewObjectID()
+
+		_, err := revisions.SaveRevision(&Revision{WordID: wordID, Number: 1, Action: RevisionCreated})
+		suite.NoError(err)
+		_, err = revisions.SaveRevision(&Revision{WordID: wordID, Number: 1, Action: RevisionUpdated})
+		suite.ErrorIs(err, ErrRevisionExists)
+		_, err = revisions.SaveRevision(&Revision{WordID: primitive.NewObjectID(), Number: 1, Action: RevisionCreated})
+		suite.NoError(err, "Expected another word to have its own numbers")
+	})
+}
+
+func (suite *WordRepoTestSuite) TestRelationRepository() {
+	suite.Run("Relations from either end", func() {
+		relations := NewRelationRepo(suite.database.DbInstance)
+		wordID := primitive.NewObjectID()
+		outgoing, err := relations.SaveRelation(&Relation{Type: RelationDerived, FromID: wordID, ToID: primitive.NewObjectID(), CreatedAt: time.Now()})
+		suite.NoError(err, "Expected no error when saving a relation")
+		_, _ = relations.SaveRelation(&Relation{Type: RelationSynonym, FromID: primitive.NewObjectID(), ToID: wordID, CreatedAt: time.Now().Add(time.Second)})
+		_, _ = relations.SaveRelation(&Relation{Type: RelationSynonym, FromID: primitive.NewObjectID(), ToID: primitive.NewObjectID()})
+
+		found, err := relations.FindRelations(wordID)
+		suite.NoError(err, "Expected no error when finding relations")
+		suite.Require().Len(found, 2)
+		suite.Equal(RelationDerived, found[0].Type)
+
+		suite.NoError(relations.DeleteRelation(outgoing.ID))
+		suite.ErrorIs(relations.DeleteRelation(outgoing.ID), ErrRelationNotFound)
+	})
+}
+
+func (suite *WordRepoTestSuite) TestWordRepository_FindByTextsAndSynonym() {
+	suite.Run("Ignoring case", func() {
+		luck := wordFixture()
+		luck.Text = "Luck"
+		luck.Synonyms = []string{"Fortune"}
+		_, _ = suite.repo.SaveWord(luck)
+		deleted := wordFixture()
+		deleted.Text = "luck"
+		deleted.DeletedAt = time.Now()
+		_, _ = suite.repo.SaveWord(deleted)
+
+		found, err := suite.repo.FindByTexts([]string{"luck", "chance"})
+		suite.NoError(err, "Expected no error when finding words by text")
+		suite.Require().Len(found, 1, "Expected deleted words to be left out")
+		suite.Equal(luck.ID, found[0].ID)
+
+		naming, err := suite.repo.FindBySynonym("fortune")
+		suite.NoError(err, "Expected no error when finding words by synonym")
+		suite.Len(naming, 1)
+
+		byIds, err := suite.repo.FindByIds([]primitive.ObjectID{luck.ID, deleted.ID})
+		suite.NoError(err, "Expected no error when finding words by ID")
+		suite.Len(byIds, 1)
 	})
 }
@@ -0,0 +1,77 @@
+package word
+
+import (
+	"reflect"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type RevisionAction string
+
+const (
+	RevisionCreated  RevisionAction = "created"
+	RevisionUpdated  RevisionAction = "updated"
+	RevisionDeleted  RevisionAction = "deleted"
+	RevisionRestored RevisionAction = "restored"
+)
+
+// Revision is an immutable record of one change to a word: who made it, when, the resulting content,
+// and how that content differs from the revision before.
+type Revision struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	WordID    primitive.ObjectID `bson:"word_id" json:"word_id"`
+	Number    int                `bson:"number" json:"number"`
+	Action    RevisionAction     `bson:"action" json:"action"`
+	Editor    string             `bson:"editor" json:"editor"`
+	CreatedAt time.Time          `bson:"created_at" json:"created_at"`
+	Content   Content            `bson:"content" json:"content"`
+	Changes   []FieldChange      `bson:"changes" json:"changes"`
+	// RestoredFrom is the number of the revision whose content was restored.
+	RestoredFrom int `bson:"restored_from,omitempty" json:"restored_from,omitempty"`
+}
+
+// FieldChange is one field that differs between two versions of a word.
+type FieldChange struct {
+	Field  string `bson:"field" json:"field"`
+	Before any    `bson:"before" json:"before"`
+	After  any    `bson:"after" json:"after"`
+}
+
+// Diff lists the fields that differ from before to after, named as in the API.
+func Diff(before, after Content) []FieldChange {
+	fields := []struct {
+		name          string
+		before, after any
+	}{
+		{"word", before.Text, after.Text},
+		{"english_meaning", before.EnglishMeaning, after.EnglishMeaning},
+		{"korean_meaning", before.KoreanMeanings, after.KoreanMeanings},
+		{"part_of_speech", before.PartsOfSpeech, after.PartsOfSpeech},
+		{"ipa", before.IPA, after.IPA},
+		{"audio_url", before.AudioURL, after.AudioURL},
+		{"description", before.Description, after.Description},
+		{"examples", before.Examples, after.Examples},
+		{"synonyms", before.Synonyms, after.Synonyms},
+		{"level", before.Level, after.Level},
+		{"tags", before.Tags, after.Tags},
+		{"senses", before.Senses, after.Senses},
+	}
+
+	changes := []FieldChange{}
+	for _, field := range fields {
+		if !sameValue(field.before, field.after) {
+			changes = append(changes, FieldChange{Field: field.name, Before: field.before, After: field.after})
+		}
+	}
+	return changes
+}
+
+// sameValue compares field values, treating a nil and an empty list as equal.
+func sameValue(a, b any) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == reflect.Slice && va.Len() == 0 && vb.Len() == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
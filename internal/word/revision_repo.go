@@ -0,0 +1,107 @@
+package word
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const revisionCollectionName = "word_revisions"
+
+// RevisionMongoRepository stores word revisions. Revisions are only ever inserted, never changed.
+type RevisionMongoRepository struct {
+	collection *mongo.Collection
+}
+
+func NewRevisionRepo(db *mongo.Database) *RevisionMongoRepository {
+	return &RevisionMongoRepository{
+		collection: db.Collection(revisionCollectionName),
+	}
+}
+
+// EnsureIndexes creates the unique index on the number of each revision of a word, if it does not exist yet,
+// so two edits saved at the same time cannot both take the next number.
+func (r *RevisionMongoRepository) EnsureIndexes() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	index := mongo.IndexModel{
+		Keys:    bson.D{{Key: "word_id", Value: 1}, {Key: "number", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}
+	if _, err := r.collection.Indexes().CreateOne(ctx, index); err != nil {
+		log.Printf("Failed to create revision indexes: %v", err)
+		return err
+	}
+	return nil
+}
+
+// SaveRevision stores a new revision. It returns ErrRevisionExists when the word already has a revision with its number.
+func (r *RevisionMongoRepository) SaveRevision(revision *Revision) (*Revision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := r.collection.InsertOne(ctx, revision)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, fmt.Errorf("%w: %d", ErrRevisionExists, revision.Number)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	revision.ID = result.InsertedID.(primitive.ObjectID)
+	return revision, nil
+}
+
+// FindRevisions returns every revision of the word, newest first.
+func (r *RevisionMongoRepository) FindRevisions(wordID primitive.ObjectID) ([]*Revision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "number", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"word_id": wordID}, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := []*Revision{}
+	if err := cursor.All(ctx, &revisions); err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+func (r *RevisionMongoRepository) FindRevision(wordID primitive.ObjectID, number int) (*Revision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	return decodeRevision(r.collection.FindOne(ctx, bson.M{"word_id": wordID, "number": number}))
+}
+
+// FindLatestRevision returns the newest revision of the word, or ErrRevisionNotFound if it has none.
+func (r *RevisionMongoRepository) FindLatestRevision(wordID primitive.ObjectID) (*Revision, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	findOptions := options.FindOne().SetSort(bson.D{{Key: "number", Value: -1}})
+	return decodeRevision(r.collection.FindOne(ctx, bson.M{"word_id": wordID}, findOptions))
+}
+
+func decodeRevision(result *mongo.SingleResult) (*Revision, error) {
+	if errors.Is(result.Err(), mongo.ErrNoDocuments) {
+		return nil, ErrRevisionNotFound
+	}
+
+	revision := &Revision{}
+	if err := result.Decode(revision); err != nil {
+		return nil, fmt.Errorf("failed to decode revision: %w", err)
+	}
+	return revision, nil
+}
@@ -0,0 +1,36 @@
+package word
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	before := Content{
+		Text:           "test",
+		EnglishMeaning: "a trial",
+		KoreanMeanings: []string{"시험"},
+		Level:          LevelB1,
+	}
+
+	t.Run("Changed fields only", func(t *testing.T) {
+		after := before
+		after.EnglishMeaning = "an exam"
+		after.KoreanMeanings = []string{"시험", "테스트"}
+
+		changes := Diff(before, after)
+
+		assert.Equal(t, []FieldChange{
+			{Field: "english_meaning", Before: "a trial", After: "an exam"},
+			{Field: "korean_meaning", Before: []string{"시험"}, After: []string{"시험", "테스트"}},
+		}, changes)
+	})
+
+	t.Run("Nil and empty lists are equal", func(t *testing.T) {
+		after := before
+		after.Tags = []string{}
+
+		assert.Empty(t, Diff(before, after))
+	})
+}
@@ -0,0 +1,35 @@
+package word
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestSelection_Matches(t *testing.T) {
+	excluded := primitive.NewObjectID()
+	selection := &Selection{ExcludeIDs: []primitive.ObjectID{excluded}, Levels: []Level{LevelB2}, Tags: []string{"business"}}
+
+	tests := []struct {
+		name string
+		word *Word
+		want bool
+	}{
+		{name: "Matching", word: &Word{Level: LevelB2, Tags: []string{"travel", "business"}, Status: StatusApproved}, want: true},
+		{name: "Not Approved", word: &Word{Level: LevelB2, Tags: []string{"business"}, Status: StatusInReview}},
+		{name: "Other Level", word: &Word{Level: LevelA1, Tags: []string{"business"}}},
+		{name: "Other Tags", word: &Word{Level: LevelB2, Tags: []string{"travel"}}},
+		{name: "Excluded", word: &Word{ID: excluded, Level: LevelB2, Tags: []string{"business"}}},
+		{name: "Deleted", word: &Word{Level: LevelB2, Tags: []string{"business"}, DeletedAt: time.Now()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, selection.Matches(tt.word))
+		})
+	}
+
+	assert.True(t, (&Selection{}).Matches(&Word{Status: StatusScheduled}), "An empty selection should match every approved word")
+}
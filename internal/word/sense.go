@@ -0,0 +1,137 @@
+package word
+
+import (
+	"slices"
+	"strings"
+)
+
+// Sense is one meaning of a word: what it means in English, how it is said in Korean, and the
+// examples and related words that go with that meaning rather than with the word as a whole.
+type Sense struct {
+	PartOfSpeech      PartOfSpeech `bson:"part_of_speech" json:"part_of_speech"`
+	EnglishDefinition string       `bson:"english_definition" json:"english_definition"`
+	KoreanGlosses     []string     `bson:"korean_glosses" json:"korean_glosses"`
+	Examples          []Example    `bson:"examples" json:"examples"`
+	Synonyms          []string     `bson:"synonyms" json:"synonyms"`
+	Antonyms          []string     `bson:"antonyms" json:"antonyms"`
+}
+
+func (s Sense) isEmpty() bool {
+	return s.EnglishDefinition == "" && len(s.KoreanGlosses) == 0 && len(s.Examples) == 0 &&
+		len(s.Synonyms) == 0 && len(s.Antonyms) == 0
+}
+
+func (s Sense) clone() Sense {
+	s.KoreanGlosses = slices.Clone(s.KoreanGlosses)
+	s.Examples = slices.Clone(s.Examples)
+	s.Synonyms = slices.Clone(s.Synonyms)
+	s.Antonyms = slices.Clone(s.Antonyms)
+	return s
+}
+
+func cloneSenses(senses []Sense) []Sense {
+	if senses == nil {
+		return nil
+	}
+	cloned := make([]Sense, 0, len(senses))
+	for _, sense := range senses {
+		cloned = append(cloned, sense.clone())
+	}
+	return cloned
+}
+
+// normalizeSenses checks the part of speech of each sense, trims its text and drops senses left with nothing in them.
+func normalizeSenses(senses []Sense) ([]Sense, error) {
+	normalized := make([]Sense, 0, len(senses))
+	for _, sense := range senses {
+		partOfSpeech, err := ParsePartOfSpeech(string(sense.PartOfSpeech))
+		if err != nil {
+			return nil, err
+		}
+		sense.PartOfSpeech = partOfSpeech
+		sense.EnglishDefinition = strings.TrimSpace(sense.EnglishDefinition)
+		sense.KoreanGlosses = nonBlank(sense.KoreanGlosses)
+		sense.Examples = slices.DeleteFunc(append([]Example{}, sense.Examples...), func(example Example) bool {
+			return strings.TrimSpace(example.ExampleText) == "" && strings.TrimSpace(example.KoreanText) == ""
+		})
+		sense.Synonyms = nonBlank(sense.Synonyms)
+		sense.Antonyms = nonBlank(sense.Antonyms)
+		if !sense.isEmpty() {
+			normalized = append(normalized, sense)
+		}
+	}
+	return normalized, nil
+}
+
+func nonBlank(values []string) []string {
+	kept := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			kept = append(kept, value)
+		}
+	}
+	return kept
+}
+
+// SensesFromMeanings groups the flat meanings words had before senses into one sense per part of
+// speech, in the order they first appear. Nothing records which meaning the English meaning,
+// examples and synonyms belong to, so they all go to the first sense.
+func SensesFromMeanings(englishMeaning string, koreanMeanings []string, partsOfSpeech []PartOfSpeech, examples []Example, synonyms []string) []Sense {
+	var senses []Sense
+	for i, meaning := range koreanMeanings {
+		var partOfSpeech PartOfSpeech
+		if i < len(partsOfSpeech) {
+			partOfSpeech = partsOfSpeech[i]
+		}
+		index := slices.IndexFunc(senses, func(sense Sense) bool { return sense.PartOfSpeech == partOfSpeech })
+		if index < 0 {
+			senses = append(senses, Sense{PartOfSpeech: partOfSpeech})
+			index = len(senses) - 1
+		}
+		senses[index].KoreanGlosses = append(senses[index].KoreanGlosses, meaning)
+	}
+
+	if englishMeaning == "" && len(examples) == 0 && len(synonyms) == 0 {
+		return senses
+	}
+	if len(senses) == 0 {
+		sense := Sense{}
+		if len(partsOfSpeech) > 0 {
+			sense.PartOfSpeech = partsOfSpeech[0]
+		}
+		senses = append(senses, sense)
+	}
+	senses[0].EnglishDefinition = englishMeaning
+	senses[0].Examples = slices.Clone(examples)
+	senses[0].Synonyms = slices.Clone(synonyms)
+	return senses
+}
+
+// applySenses fills the flat meaning fields from the senses, for the readers and API clients
+// that predate senses: meanings and examples in sense order, and each synonym once.
+func (c *Content) applySenses() {
+	var definitions []string
+	c.KoreanMeanings = []string{}
+	c.PartsOfSpeech = []PartOfSpeech{}
+	c.Examples = []Example{}
+	c.Synonyms = []string{}
+	for _, sense := range c.Senses {
+		if sense.EnglishDefinition != "" {
+			definitions = append(definitions, sense.EnglishDefinition)
+		}
+		for _, gloss := range sense.KoreanGlosses {
+			c.KoreanMeanings = append(c.KoreanMeanings, gloss)
+			c.PartsOfSpeech = append(c.PartsOfSpeech, sense.PartOfSpeech)
+		}
+		c.Examples = append(c.Examples, sense.Examples...)
+		for _, synonym := range sense.Synonyms {
+			if !slices.Contains(c.Synonyms, synonym) {
+				c.Synonyms = append(c.Synonyms, synonym)
+			}
+		}
+	}
+	c.EnglishMeaning = strings.Join(definitions, "; ")
+	for len(c.PartsOfSpeech) > 0 && c.PartsOfSpeech[len(c.PartsOfSpeech)-1] == "" {
+		c.PartsOfSpeech = c.PartsOfSpeech[:len(c.PartsOfSpeech)-1]
+	}
+}
@@ -0,0 +1,52 @@
+package word
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSensesFromMeanings(t *testing.T) {
+	examples := []Example{{ExampleText: "We have a test tomorrow."}}
+
+	t.Run("One sense per part of speech", func(t *testing.T) {
+		senses := SensesFromMeanings("a trial", []string{"시험", "시험하다", "검사"}, []PartOfSpeech{Noun, Verb, Noun}, examples, []string{"exam"})
+
+		assert.Equal(t, []Sense{
+			{PartOfSpeech: Noun, EnglishDefinition: "a trial", KoreanGlosses: []string{"시험", "검사"}, Examples: examples, Synonyms: []string{"exam"}},
+			{PartOfSpeech: Verb, KoreanGlosses: []string{"시험하다"}},
+		}, senses)
+	})
+
+	t.Run("English meaning without Korean meanings", func(t *testing.T) {
+		senses := SensesFromMeanings("a trial", nil, nil, nil, nil)
+
+		assert.Equal(t, []Sense{{EnglishDefinition: "a trial"}}, senses)
+	})
+
+	t.Run("Nothing to convert", func(t *testing.T) {
+		assert.Empty(t, SensesFromMeanings("", nil, nil, nil, nil))
+	})
+}
+
+func TestContent_NormalizedDerivesFlatFieldsFromSenses(t *testing.T) {
+	content, err := Content{
+		Text:           "test",
+		EnglishMeaning: "ignored",
+		Senses: []Sense{
+			{PartOfSpeech: "n.", EnglishDefinition: "a trial", KoreanGlosses: []string{"시험", " "}, Synonyms: []string{"exam"}},
+			{},
+			{PartOfSpeech: Verb, EnglishDefinition: "to try", KoreanGlosses: []string{"시험하다"}, Synonyms: []string{"exam", "try"}, Antonyms: []string{"ignore"}},
+		},
+	}.normalized()
+
+	assert.NoError(t, err)
+	assert.Len(t, content.Senses, 2, "Expected empty senses to be dropped")
+	assert.Equal(t, "a trial; to try", content.EnglishMeaning)
+	assert.Equal(t, []string{"시험", "시험하다"}, content.KoreanMeanings)
+	assert.Equal(t, []PartOfSpeech{Noun, Verb}, content.PartsOfSpeech)
+	assert.Equal(t, []string{"exam", "try"}, content.Synonyms)
+
+	_, err = Content{Text: "test", Senses: []Sense{{PartOfSpeech: "gerund", KoreanGlosses: []string{"시험"}}}}.normalized()
+	assert.ErrorIs(t, err, ErrInvalidPartOfSpeech)
+}
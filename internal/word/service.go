@@ -1,68 +1,697 @@
 package word
 
-import "github.com/Go-roro/wordrop/internal/common"
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Go-roro/wordrop/internal/common"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
 
 type Repository interface {
 	SaveWord(word *Word) (*Word, error)
 	FindById(id string) (*Word, error)
 	FindWords(params *SearchParams) (*common.PageResult[*Word], error)
 	UpdateWord(word *Word) error
+	FindLatestDelivered() (*Word, error)
+	FindDeliveredByText(text string) (*Word, error)
+	FindNextWord(selection *Selection) (*Word, error)
+	FindUndeliveredWords(excludeIDs []primitive.ObjectID, limit int) ([]*Word, error)
+	SampleWords(size int, excludeIDs []primitive.ObjectID) ([]*Word, error)
+	MigrateStatuses() (int64, error)
+	MigrateSenses() (int64, error)
+	FindByTexts(texts []string) ([]*Word, error)
+	FindBySynonym(text string) ([]*Word, error)
+	FindByIds(ids []primitive.ObjectID) ([]*Word, error)
+}
+
+// maxRevisionAttempts is how many times a revision is numbered anew when concurrent edits keep taking its number.
+const maxRevisionAttempts = 5
+
+// Revisions keeps the history of every change made to a word.
+type Revisions interface {
+	SaveRevision(revision *Revision) (*Revision, error)
+	FindRevisions(wordID primitive.ObjectID) ([]*Revision, error)
+	FindRevision(wordID primitive.ObjectID, number int) (*Revision, error)
+	FindLatestRevision(wordID primitive.ObjectID) (*Revision, error)
+}
+
+// Relations keeps the links between words.
+type Relations interface {
+	SaveRelation(relation *Relation) (*Relation, error)
+	FindRelations(wordID primitive.ObjectID) ([]*Relation, error)
+	DeleteRelation(id primitive.ObjectID) error
 }
 
 type Service struct {
 	repository Repository
+	revisions  Revisions
+	relations  Relations
 }
 
-func NewWordService(repo Repository) *Service {
-	return &Service{repository: repo}
+func NewWordService(repo Repository, revisions Revisions, relations Relations) *Service {
+	return &Service{repository: repo, revisions: revisions, relations: relations}
 }
 
 func (s *Service) SaveNewWord(saveDto *SaveWordDto) (*Word, error) {
-	word := &Word{
-		Text:           saveDto.Text,
-		EnglishMeaning: saveDto.EnglishMeaning,
-		KoreanMeanings: saveDto.KoreanMeanings,
-		Description:    saveDto.Description,
-		Synonyms:       saveDto.Synonyms,
-		Examples:       saveDto.Examples,
-		IsDelivered:    false,
+	content, err := saveDto.content()
+	if err != nil {
+		return nil, err
 	}
 
+	word := &Word{Status: StatusDraft, IsDelivered: false, CreatedBy: saveDto.Editor}
+	word.applyContent(content)
+
 	savedWord, err := s.repository.SaveWord(word)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := s.recordRevision(savedWord, RevisionCreated, saveDto.Editor, savedWord.CreatedAt, 0); err != nil {
+		return nil, err
+	}
+	s.linkSynonyms(savedWord, savedWord.CreatedAt)
 	return savedWord, nil
 }
 
 func (s *Service) UpdateWord(updateDto *UpdateWordDto) error {
-	word, err := s.repository.FindById(updateDto.ID)
+	content, err := updateDto.content()
+	if err != nil {
+		return err
+	}
+
+	word, err := s.editableWord(updateDto.ID)
+	if err != nil {
+		return err
+	}
+	if updateDto.Version != nil && *updateDto.Version != word.Version {
+		return ErrVersionConflict
+	}
+
+	return s.editWord(word, content, updateDto.Editor, time.Now())
+}
+
+// PatchWord applies a JSON Merge Patch to what editors write on a word, leaving its delivery state alone.
+// version is the version of the word the editor last read, nil to patch whatever is stored; the patch is
+// rejected with ErrVersionConflict if the word changed since.
+func (s *Service) PatchWord(id string, patch []byte, version *int, editor string, now time.Time) (*Word, error) {
+	word, err := s.editableWord(id)
+	if err != nil {
+		return nil, err
+	}
+	if version != nil && *version != word.Version {
+		return nil, ErrVersionConflict
+	}
+
+	content, err := ApplyMergePatch(word.content(), patch)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.editWord(word, content, editor, now); err != nil {
+		return nil, err
+	}
+	return word, nil
+}
+
+// editWord saves new content on a word and records the change in its history.
+func (s *Service) editWord(word *Word, content Content, editor string, now time.Time) error {
+	// The history must hold what the word looked like before this edit, even for words older than the history itself.
+	if err := s.ensureBaseline(word); err != nil {
+		return err
+	}
+
+	before := word.content()
+	word.applyContent(content)
+	word.UpdatedAt = now
+	if err := s.repository.UpdateWord(word); err != nil {
+		return err
+	}
+
+	if err := s.recordRevision(word, RevisionUpdated, editor, now, 0); err != nil {
+		return err
+	}
+	s.relinkSynonyms(before, word, now)
+	return nil
+}
+
+func (s *Service) FindWord(id string) (*Word, error) {
+	return s.repository.FindById(id)
+}
+
+// DeleteWord soft-deletes a word so it is no longer listed, published or delivered.
+func (s *Service) DeleteWord(id, editor string, now time.Time) error {
+	word, err := s.repository.FindById(id)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrWordNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if word.IsDeleted() {
+		return nil
+	}
+
+	if err := s.ensureBaseline(word); err != nil {
+		return err
+	}
+
+	word.DeletedAt = now
+	if err := s.repository.UpdateWord(word); err != nil {
+		return err
+	}
+	return s.recordRevision(word, RevisionDeleted, editor, now, 0)
+}
+
+// ChangeStatus moves a word through the editorial workflow, e.g. submitting a draft for review or approving it.
+// An optional comment is kept with the word, such as the reason a reviewer sent it back to draft.
+func (s *Service) ChangeStatus(id string, to Status, author, comment string, now time.Time) (*Word, error) {
+	if !slices.Contains(editorialStatuses, to) {
+		return nil, fmt.Errorf("%w: %s is set by the schedule and deliveries", ErrInvalidTransition, to)
+	}
+
+	word, err := s.editableWord(id)
+	if err != nil {
+		return nil, err
+	}
+	if !word.Status.CanTransitionTo(to) {
+		return nil, fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, word.Status, to)
+	}
+	if to == StatusApproved {
+		if err := s.checkReviewer(word, author); err != nil {
+			return nil, err
+		}
+	}
+
+	word.Status = to
+	if comment = strings.TrimSpace(comment); comment != "" {
+		word.Comments = append(word.Comments, Comment{Author: author, Text: comment, Status: to, CreatedAt: now})
+	}
+	word.UpdatedAt = now
+	if err := s.repository.UpdateWord(word); err != nil {
+		return nil, err
+	}
+	return word, nil
+}
+
+// checkReviewer makes sure a word is approved by someone other than the editor who added it.
+func (s *Service) checkReviewer(word *Word, reviewer string) error {
+	if reviewer == "" {
+		return ErrReviewerRequired
+	}
+	author, err := s.authorOf(word)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(author, reviewer) {
+		return ErrSelfApproval
+	}
+	return nil
+}
+
+// authorOf returns who added a word. Words added before authors were kept on them are looked up in their
+// first revision; a word older than its revision history has no known author.
+func (s *Service) authorOf(word *Word) (string, error) {
+	if word.CreatedBy != "" {
+		return word.CreatedBy, nil
+	}
+	first, err := s.revisions.FindRevision(word.ID, 1)
+	if errors.Is(err, ErrRevisionNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return first.Editor, nil
+}
+
+// AddComment leaves a reviewer's comment on a word without changing its status.
+func (s *Service) AddComment(id, author, text string, now time.Time) (*Word, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, ErrEmptyComment
+	}
+
+	word, err := s.editableWord(id)
+	if err != nil {
+		return nil, err
+	}
+
+	word.Comments = append(word.Comments, Comment{Author: author, Text: text, CreatedAt: now})
+	if err := s.repository.UpdateWord(word); err != nil {
+		return nil, err
+	}
+	return word, nil
+}
+
+// AddAttachment records a file uploaded to media storage for a word.
+func (s *Service) AddAttachment(wordID string, attachment Attachment) (*Word, error) {
+	word, err := s.editableWord(wordID)
+	if err != nil {
+		return nil, err
+	}
+
+	word.Attachments = append(word.Attachments, attachment)
+	if err := s.repository.UpdateWord(word); err != nil {
+		return nil, err
+	}
+	return word, nil
+}
+
+// RemoveAttachment takes an attachment off a word and returns it, so its file can be removed from media storage.
+func (s *Service) RemoveAttachment(wordID, attachmentID string) (*Attachment, error) {
+	word, err := s.editableWord(wordID)
+	if err != nil {
+		return nil, err
+	}
+	attachment, ok := word.Attachment(attachmentID)
+	if !ok {
+		return nil, ErrAttachmentNotFound
+	}
+
+	word.Attachments = slices.DeleteFunc(word.Attachments, func(a Attachment) bool { return a.ID == attachmentID })
+	if err := s.repository.UpdateWord(word); err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// SetScheduled moves an approved word to scheduled when it is put on the schedule, and back to approved
+// when it is taken off. Words in any other status, such as delivered ones, are left as they are.
+func (s *Service) SetScheduled(wordID primitive.ObjectID, scheduled bool) error {
+	word, err := notFoundAsErr(s.repository.FindById(wordID.Hex()))
+	if err != nil {
+		return err
+	}
+
+	to := StatusApproved
+	if scheduled {
+		to = StatusScheduled
+	}
+	if word.Status == to || !word.Status.CanTransitionTo(to) {
+		return nil
+	}
+
+	word.Status = to
+	return s.repository.UpdateWord(word)
+}
+
+// MigrateStatuses gives words saved before the editorial workflow a status. It is safe to run on every start.
+func (s *Service) MigrateStatuses() (int64, error) {
+	return s.repository.MigrateStatuses()
+}
+
+// MigrateSenses gives words saved before senses their senses. It is safe to run on every start.
+func (s *Service) MigrateSenses() (int64, error) {
+	return s.repository.MigrateSenses()
+}
+
+func (s *Service) editableWord(id string) (*Word, error) {
+	word, err := notFoundAsErr(s.repository.FindById(id))
+	if err != nil {
+		return nil, err
+	}
+	if word.IsDeleted() {
+		return nil, ErrWordNotFound
+	}
+	return word, nil
+}
+
+// Revisions returns the history of a word, newest revision first.
+func (s *Service) Revisions(wordID string) ([]*Revision, error) {
+	word, err := notFoundAsErr(s.repository.FindById(wordID))
+	if err != nil {
+		return nil, err
+	}
+	return s.revisions.FindRevisions(word.ID)
+}
+
+// DiffRevisions lists the fields that changed from revision from to revision to of a word.
+func (s *Service) DiffRevisions(wordID string, from, to int) ([]FieldChange, error) {
+	id, err := primitive.ObjectIDFromHex(wordID)
+	if err != nil {
+		return nil, ErrWordNotFound
+	}
+
+	fromRevision, err := s.revisions.FindRevision(id, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := s.revisions.FindRevision(id, to)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(fromRevision.Content, toRevision.Content), nil
+}
+
+// RestoreRevision puts the content of an older revision back on the word. The restore is itself
+// recorded as a new revision, so it can be undone the same way. version is the version of the word the editor
+// last read, nil to restore onto whatever is stored; the restore is rejected with ErrVersionConflict if the word
+// changed since.
+func (s *Service) RestoreRevision(wordID string, number int, version *int, editor string, now time.Time) (*Word, error) {
+	word, err := s.editableWord(wordID)
+	if err != nil {
+		return nil, err
+	}
+	if version != nil && *version != word.Version {
+		return nil, ErrVersionConflict
+	}
+
+	revision, err := s.revisions.FindRevision(word.ID, number)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revisions recorded before senses existed only have the flat meaning fields.
+	content, err := revision.Content.normalized()
+	if err != nil {
+		return nil, err
+	}
+	before := word.content()
+	word.applyContent(content)
+	word.UpdatedAt = now
+	if err := s.repository.UpdateWord(word); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordRevision(word, RevisionRestored, editor, now, number); err != nil {
+		return nil, err
+	}
+	s.relinkSynonyms(before, word, now)
+	return word, nil
+}
+
+// RelateWords links a word to another. Relating a word to its base form stores a derived relation from the base form.
+func (s *Service) RelateWords(wordID, otherID string, relationType RelationType, editor string, now time.Time) (*RelatedWord, error) {
+	word, err := s.editableWord(wordID)
+	if err != nil {
+		return nil, err
+	}
+	other, err := s.editableWord(otherID)
+	if err != nil {
+		return nil, err
+	}
+	if word.ID == other.ID {
+		return nil, ErrSelfRelation
+	}
+
+	relation := &Relation{Type: relationType, FromID: word.ID, ToID: other.ID, CreatedBy: editor, CreatedAt: now}
+	if relationType == RelationBaseForm {
+		relation.Type = RelationDerived
+		relation.FromID, relation.ToID = other.ID, word.ID
+	}
+
+	relations, err := s.relations.FindRelations(word.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range relations {
+		if existing.Type == relation.Type && existing.Other(word.ID) == other.ID {
+			return nil, ErrRelationExists
+		}
+	}
+
+	saved, err := s.relations.SaveRelation(relation)
+	if err != nil {
+		return nil, err
+	}
+	return &RelatedWord{RelationID: saved.ID, Type: relationType, WordID: other.ID, Text: other.Text}, nil
+}
+
+// UnrelateWords removes one of the relations of a word.
+func (s *Service) UnrelateWords(wordID, relationID string) error {
+	word, err := s.editableWord(wordID)
+	if err != nil {
+		return err
+	}
+	id, err := primitive.ObjectIDFromHex(relationID)
+	if err != nil {
+		return ErrRelationNotFound
+	}
+
+	relations, err := s.relations.FindRelations(word.ID)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(relations, func(relation *Relation) bool { return relation.ID == id }) {
+		return ErrRelationNotFound
+	}
+	return s.relations.DeleteRelation(id)
+}
+
+// RelatedWords lists the words linked to a word, leaving out deleted ones.
+func (s *Service) RelatedWords(wordID string) ([]*RelatedWord, error) {
+	word, err := s.editableWord(wordID)
+	if err != nil {
+		return nil, err
+	}
+	relations, err := s.relations.FindRelations(word.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(relations))
+	for _, relation := range relations {
+		ids = append(ids, relation.Other(word.ID))
+	}
+	others, err := s.repository.FindByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	texts := make(map[primitive.ObjectID]string, len(others))
+	for _, other := range others {
+		texts[other.ID] = other.Text
+	}
+
+	related := make([]*RelatedWord, 0, len(relations))
+	for _, relation := range relations {
+		otherID := relation.Other(word.ID)
+		text, ok := texts[otherID]
+		if !ok {
+			continue
+		}
+		related = append(related, &RelatedWord{
+			RelationID: relation.ID,
+			Type:       relation.TypeFrom(word.ID),
+			WordID:     otherID,
+			Text:       text,
+			Auto:       relation.Auto,
+		})
+	}
+	return related, nil
+}
+
+// relinkSynonyms links the synonyms of an edited word again if its text or synonyms changed.
+func (s *Service) relinkSynonyms(before Content, word *Word, now time.Time) {
+	if before.Text != word.Text || !sameValue(before.Synonyms, word.Synonyms) {
+		s.linkSynonyms(word, now)
+	}
+}
+
+// linkSynonyms keeps the automatic synonym relations of a word in line with the synonyms written on the words:
+// a word is linked to every word its synonyms name and to every word naming it as a synonym. Relations made
+// by an editor are left alone. Failing to link does not undo the edit, so errors are only logged.
+func (s *Service) linkSynonyms(word *Word, now time.Time) {
+	if err := s.syncSynonymRelations(word, now); err != nil {
+		log.Printf("Failed to link synonyms of word %s: %v", word.ID.Hex(), err)
+	}
+}
+
+func (s *Service) syncSynonymRelations(word *Word, now time.Time) error {
+	var matching []*Word
+	if len(word.Synonyms) > 0 {
+		named, err := s.repository.FindByTexts(word.Synonyms)
+		if err != nil {
+			return err
+		}
+		matching = append(matching, named...)
+	}
+	naming, err := s.repository.FindBySynonym(word.Text)
 	if err != nil {
 		return err
 	}
-	updateWord := &Word{
-		Text:           updateDto.Text,
-		EnglishMeaning: updateDto.EnglishMeaning,
-		KoreanMeanings: updateDto.KoreanMeanings,
-		Description:    updateDto.Description,
-		Synonyms:       updateDto.Synonyms,
-		Examples:       updateDto.Examples,
-		IsDelivered:    false,
-		CreatedAt:      word.CreatedAt,
+	matching = append(matching, naming...)
+
+	var wanted []primitive.ObjectID
+	for _, other := range matching {
+		if other.ID != word.ID && !slices.Contains(wanted, other.ID) {
+			wanted = append(wanted, other.ID)
+		}
 	}
 
-	err = s.repository.UpdateWord(updateWord)
+	relations, err := s.relations.FindRelations(word.ID)
 	if err != nil {
 		return err
 	}
+	for _, relation := range relations {
+		if relation.Type != RelationSynonym {
+			continue
+		}
+		if index := slices.Index(wanted, relation.Other(word.ID)); index >= 0 {
+			wanted = slices.Delete(wanted, index, index+1)
+			continue
+		}
+		if relation.Auto {
+			if err := s.relations.DeleteRelation(relation.ID); err != nil {
+				return err
+			}
+		}
+	}
 
+	for _, otherID := range wanted {
+		relation := &Relation{Type: RelationSynonym, FromID: word.ID, ToID: otherID, Auto: true, CreatedAt: now}
+		if _, err := s.relations.SaveRelation(relation); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// ensureBaseline records the current content of a word as its first revision if it has no history yet.
+func (s *Service) ensureBaseline(word *Word) error {
+	_, err := s.revisions.FindLatestRevision(word.ID)
+	if !errors.Is(err, ErrRevisionNotFound) {
+		return err
+	}
+
+	_, err = s.revisions.SaveRevision(&Revision{
+		WordID:    word.ID,
+		Number:    1,
+		Action:    RevisionCreated,
+		CreatedAt: word.CreatedAt,
+		Content:   word.content(),
+		Changes:   Diff(Content{}, word.content()),
+	})
+	if errors.Is(err, ErrRevisionExists) {
+		// A concurrent edit recorded the baseline first.
+		return nil
+	}
+	return err
+}
+
+// recordRevision appends the current content of word to its history, with the fields changed since the previous
+// revision. When a concurrent edit takes the next number first, it tries again after that edit's revision.
+func (s *Service) recordRevision(word *Word, action RevisionAction, editor string, now time.Time, restoredFrom int) error {
+	var err error
+	for range maxRevisionAttempts {
+		previous := &Revision{}
+		latest, findErr := s.revisions.FindLatestRevision(word.ID)
+		if findErr == nil {
+			previous = latest
+		} else if !errors.Is(findErr, ErrRevisionNotFound) {
+			return findErr
+		}
+
+		_, err = s.revisions.SaveRevision(&Revision{
+			WordID:       word.ID,
+			Number:       previous.Number + 1,
+			Action:       action,
+			Editor:       editor,
+			CreatedAt:    now,
+			Content:      word.content(),
+			Changes:      Diff(previous.Content, word.content()),
+			RestoredFrom: restoredFrom,
+		})
+		if !errors.Is(err, ErrRevisionExists) {
+			return err
+		}
+	}
+	return err
+}
+
 func (s *Service) FindWords(params *SearchParams) (*common.PageResult[*Word], error) {
 	if params == nil {
 		params = &SearchParams{}
 	}
 	return s.repository.FindWords(params)
 }
+
+// TodayWord returns the most recently delivered word.
+func (s *Service) TodayWord() (*Word, error) {
+	return notFoundAsErr(s.repository.FindLatestDelivered())
+}
+
+// FindDeliveredWord returns the delivered word spelled text. Words that have not been sent yet stay hidden.
+func (s *Service) FindDeliveredWord(text string) (*Word, error) {
+	return notFoundAsErr(s.repository.FindDeliveredByText(text))
+}
+
+// DeliveredWords returns a page of delivered words, most recently delivered first.
+func (s *Service) DeliveredWords(page, pageSize int) (*common.PageResult[*Word], error) {
+	isDelivered := true
+	return s.repository.FindWords(&SearchParams{
+		IsDelivered: &isDelivered,
+		Page:        page,
+		PageSize:    pageSize,
+		SortBy:      "delivered_at",
+		SortOrder:   "desc",
+	})
+}
+
+func notFoundAsErr(word *Word, err error) (*Word, error) {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrWordNotFound
+	}
+	return word, err
+}
+
+// NextWord returns the oldest word matching selection, so a subscriber walks through
+// the whole word list in the order words were added, starting from the first one.
+func (s *Service) NextWord(selection *Selection) (*Word, error) {
+	next, err := s.repository.FindNextWord(selection)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNoWordAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	return next, nil
+}
+
+// MarkDelivered records the first time a word was sent to anyone.
+func (s *Service) MarkDelivered(word *Word, deliveredAt time.Time) error {
+	if word.IsDelivered {
+		return nil
+	}
+
+	word.IsDelivered = true
+	word.DeliveredAt = deliveredAt
+	word.Status = StatusDelivered
+	err := s.repository.UpdateWord(word)
+	if !errors.Is(err, ErrVersionConflict) {
+		return err
+	}
+
+	// An editor changed the word after it was picked; mark the stored word rather than overwrite the edit.
+	current, err := s.repository.FindById(word.ID.Hex())
+	if err != nil {
+		return err
+	}
+	if current.IsDelivered {
+		return nil
+	}
+	current.IsDelivered = true
+	current.DeliveredAt = deliveredAt
+	current.Status = StatusDelivered
+	return s.repository.UpdateWord(current)
+}
+
+// SampleWords returns up to size random words, e.g. to draw quiz distractors from.
+func (s *Service) SampleWords(size int, excludeIDs []primitive.ObjectID) ([]*Word, error) {
+	if excludeIDs == nil {
+		excludeIDs = []primitive.ObjectID{}
+	}
+	return s.repository.SampleWords(size, excludeIDs)
+}
+
+// UndeliveredWords returns up to limit words nobody has received yet, oldest first, skipping excludeIDs.
+func (s *Service) UndeliveredWords(excludeIDs []primitive.ObjectID, limit int) ([]*Word, error) {
+	if excludeIDs == nil {
+		excludeIDs = []primitive.ObjectID{}
+	}
+	return s.repository.FindUndeliveredWords(excludeIDs, limit)
+}
@@ -0,0 +1,538 @@
+package word
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type WordServiceTestSuite struct {
+	suite.Suite
+	mockRepo      *MockRepository
+	mockRevisions *MockRevisions
+	mockRelations *MockRelations
+	service       *Service
+}
+
+func (suite *WordServiceTestSuite) SetupTest() {
+	suite.mockRepo = new(MockRepository)
+	suite.mockRevisions = new(MockRevisions)
+	suite.mockRelations = new(MockRelations)
+	suite.service = NewWordService(suite.mockRepo, suite.mockRevisions, suite.mockRelations)
+}
+
+// expectNoSynonymMatches lets saved words look for synonyms to link without finding any.
+func (suite *WordServiceTestSuite) expectNoSynonymMatches() {
+	suite.mockRepo.EXPECT().FindByTexts(mock.Anything).Return([]*Word{}, nil).Maybe()
+	suite.mockRepo.EXPECT().FindBySynonym(mock.Anything).Return([]*Word{}, nil).Maybe()
+	suite.mockRelations.EXPECT().FindRelations(mock.Anything).Return([]*Relation{}, nil).Maybe()
+}
+
+func TestWordServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(WordServiceTestSuite))
+}
+
+func (suite *WordServiceTestSuite) TestSaveNewWord_RecordsRevision() {
+	// Given
+	saved := &Word{ID: primitive.NewObjectID(), Text: "serendipity", CreatedAt: time.Now()}
+	suite.expectNoSynonymMatches()
+	suite.mockRepo.EXPECT().SaveWord(mock.Anything).Return(saved, nil)
+	suite.mockRevisions.EXPECT().FindLatestRevision(saved.ID).Return(nil, ErrRevisionNotFound)
+	suite.mockRevisions.EXPECT().SaveRevision(mock.MatchedBy(func(revision *Revision) bool {
+		return revision.WordID == saved.ID && revision.Number == 1 && revision.Action == RevisionCreated &&
+			revision.Editor == "editor@wordrop.com" && len(revision.Changes) == 1
+	})).Return(&Revision{}, nil)
+
+	// When
+	_, err := suite.service.SaveNewWord(&SaveWordDto{Text: "serendipity", Editor: "editor@wordrop.com"})
+
+	// Then
+	suite.NoError(err, "Expected no error when saving a word")
+	suite.mockRevisions.AssertExpectations(suite.T())
+}
+
+func (suite *WordServiceTestSuite) TestUpdateWord_KeepsDeliveryStateAndRecordsDiff() {
+	// Given
+	deliveredAt := time.Now().Add(-24 * time.Hour)
+	existing := &Word{ID: primitive.NewObjectID(), Text: "test", EnglishMeaning: "a trial", Senses: []Sense{{EnglishDefinition: "a trial"}}, IsDelivered: true, DeliveredAt: deliveredAt}
+	latest := &Revision{WordID: existing.ID, Number: 2, Content: existing.content()}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+	suite.mockRevisions.EXPECT().FindLatestRevision(existing.ID).Return(latest, nil)
+	suite.mockRepo.EXPECT().UpdateWord(mock.MatchedBy(func(word *Word) bool {
+		return word.ID == existing.ID && word.IsDelivered && word.DeliveredAt.Equal(deliveredAt) && word.EnglishMeaning == "an exam"
+	})).Return(nil)
+	suite.mockRevisions.EXPECT().SaveRevision(mock.MatchedBy(func(revision *Revision) bool {
+		return revision.Number == 3 && revision.Action == RevisionUpdated &&
+			len(revision.Changes) == 2 && revision.Changes[0].Field == "english_meaning" && revision.Changes[1].Field == "senses"
+	})).Return(&Revision{}, nil)
+
+	// When
+	err := suite.service.UpdateWord(&UpdateWordDto{ID: existing.ID.Hex(), Text: "test", EnglishMeaning: "an exam"})
+
+	// Then
+	suite.NoError(err, "Expected no error when updating a word")
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockRevisions.AssertExpectations(suite.T())
+}
+
+func (suite *WordServiceTestSuite) TestUpdateWord_Rejected() {
+	tests := []struct {
+		name     string
+		existing *Word
+		version  int
+		wantErr  error
+	}{
+		{name: "Stale Version", existing: &Word{ID: primitive.NewObjectID(), Text: "test", Version: 3}, version: 2, wantErr: ErrVersionConflict},
+		{name: "Deleted Word", existing: &Word{ID: primitive.NewObjectID(), Text: "test", Version: 3, DeletedAt: time.Now()}, version: 3, wantErr: ErrWordNotFound},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			// Given
+			suite.SetupTest()
+			suite.mockRepo.EXPECT().FindById(tt.existing.ID.Hex()).Return(tt.existing, nil)
+
+			// When
+			err := suite.service.UpdateWord(&UpdateWordDto{ID: tt.existing.ID.Hex(), Text: "test", Version: &tt.version})
+
+			// Then
+			suite.ErrorIs(err, tt.wantErr)
+			suite.mockRepo.AssertNotCalled(suite.T(), "UpdateWord", mock.Anything)
+		})
+	}
+}
+
+func (suite *WordServiceTestSuite) TestUpdateWord_RecordsBaselineForWordWithoutHistory() {
+	// Given
+	existing := &Word{ID: primitive.NewObjectID(), Text: "test", EnglishMeaning: "a trial"}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+	suite.mockRevisions.EXPECT().FindLatestRevision(existing.ID).Return(nil, ErrRevisionNotFound).Once()
+	suite.mockRevisions.EXPECT().SaveRevision(mock.MatchedBy(func(revision *Revision) bool {
+		return revision.Number == 1 && revision.Content.EnglishMeaning == "a trial"
+	})).Return(&Revision{}, nil).Once()
+	suite.mockRepo.EXPECT().UpdateWord(mock.Anything).Return(nil)
+	baseline := &Revision{WordID: existing.ID, Number: 1, Content: Content{Text: "test", EnglishMeaning: "a trial"}}
+	suite.mockRevisions.EXPECT().FindLatestRevision(existing.ID).Return(baseline, nil).Once()
+	suite.mockRevisions.EXPECT().SaveRevision(mock.MatchedBy(func(revision *Revision) bool {
+		return revision.Number == 2 && revision.Content.EnglishMeaning == "an exam"
+	})).Return(&Revision{}, nil).Once()
+
+	// When
+	err := suite.service.UpdateWord(&UpdateWordDto{ID: existing.ID.Hex(), Text: "test", EnglishMeaning: "an exam"})
+
+	// Then
+	suite.NoError(err, "Expected no error when updating a word")
+	suite.mockRevisions.AssertExpectations(suite.T())
+}
+
+func (suite *WordServiceTestSuite) TestUpdateWord_RenumbersRevisionTakenByConcurrentEdit() {
+	// Given
+	existing := &Word{ID: primitive.NewObjectID(), Text: "test", EnglishMeaning: "a trial"}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+	suite.mockRepo.EXPECT().UpdateWord(mock.Anything).Return(nil)
+	suite.mockRevisions.EXPECT().FindLatestRevision(existing.ID).Return(&Revision{Number: 2, Content: existing.content()}, nil).Twice()
+	suite.mockRevisions.EXPECT().SaveRevision(mock.MatchedBy(func(revision *Revision) bool { return revision.Number == 3 })).
+		Return(nil, ErrRevisionExists).Once()
+	concurrent := &Revision{Number: 3, Content: Content{Text: "test", EnglishMeaning: "a check"}}
+	suite.mockRevisions.EXPECT().FindLatestRevision(existing.ID).Return(concurrent, nil).Once()
+	suite.mockRevisions.EXPECT().SaveRevision(mock.MatchedBy(func(revision *Revision) bool {
+		return revision.Number == 4 && len(revision.Changes) > 0 && revision.Changes[0].Before == "a check"
+	})).Return(&Revision{}, nil).Once()
+
+	// When
+	err := suite.service.UpdateWord(&UpdateWordDto{ID: existing.ID.Hex(), Text: "test", EnglishMeaning: "an exam"})
+
+	// Then
+	suite.NoError(err)
+	suite.mockRevisions.AssertExpectations(suite.T())
+}
+
+func (suite *WordServiceTestSuite) TestRestoreRevision() {
+	// Given
+	now := time.Now()
+	existing := &Word{ID: primitive.NewObjectID(), Text: "test", EnglishMeaning: "a mistake", IsDelivered: true}
+	restored := &Revision{WordID: existing.ID, Number: 1, Content: Content{Text: "test", EnglishMeaning: "a trial"}}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+	suite.mockRevisions.EXPECT().FindRevision(existing.ID, 1).Return(restored, nil)
+	suite.mockRepo.EXPECT().UpdateWord(mock.MatchedBy(func(word *Word) bool {
+		return word.EnglishMeaning == "a trial" && word.IsDelivered
+	})).Return(nil)
+	suite.mockRevisions.EXPECT().FindLatestRevision(existing.ID).Return(&Revision{Number: 2, Content: Content{Text: "test", EnglishMeaning: "a mistake"}}, nil)
+	suite.mockRevisions.EXPECT().SaveRevision(mock.MatchedBy(func(revision *Revision) bool {
+		return revision.Number == 3 && revision.Action == RevisionRestored && revision.RestoredFrom == 1 && revision.CreatedAt.Equal(now)
+	})).Return(&Revision{}, nil)
+
+	// When
+	word, err := suite.service.RestoreRevision(existing.ID.Hex(), 1, nil, "editor@wordrop.com", now)
+
+	// Then
+	suite.NoError(err, "Expected no error when restoring a revision")
+	suite.Equal("a trial", word.EnglishMeaning)
+	suite.mockRevisions.AssertExpectations(suite.T())
+}
+
+func (suite *WordServiceTestSuite) TestRestoreRevision_Rejected() {
+	suite.Run("Deleted Word", func() {
+		deleted := &Word{ID: primitive.NewObjectID(), Text: "test", DeletedAt: time.Now()}
+		suite.mockRepo.EXPECT().FindById(deleted.ID.Hex()).Return(deleted, nil).Once()
+
+		_, err := suite.service.RestoreRevision(deleted.ID.Hex(), 1, nil, "editor@wordrop.com", time.Now())
+		suite.ErrorIs(err, ErrWordNotFound)
+	})
+
+	suite.Run("Stale Version", func() {
+		existing := &Word{ID: primitive.NewObjectID(), Text: "test", Version: 3}
+		suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil).Once()
+		stale := 2
+
+		_, err := suite.service.RestoreRevision(existing.ID.Hex(), 1, &stale, "editor@wordrop.com", time.Now())
+		suite.ErrorIs(err, ErrVersionConflict)
+	})
+
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateWord", mock.Anything)
+}
+
+func (suite *WordServiceTestSuite) TestDiffRevisions() {
+	// Given
+	wordID := primitive.NewObjectID()
+	suite.mockRevisions.EXPECT().FindRevision(wordID, 1).Return(&Revision{Content: Content{Text: "test", Level: LevelA1}}, nil)
+	suite.mockRevisions.EXPECT().FindRevision(wordID, 3).Return(&Revision{Content: Content{Text: "test", Level: LevelB2}}, nil)
+
+	// When
+	changes, err := suite.service.DiffRevisions(wordID.Hex(), 1, 3)
+
+	// Then
+	suite.NoError(err, "Expected no error when diffing revisions")
+	suite.Equal([]FieldChange{{Field: "level", Before: LevelA1, After: LevelB2}}, changes)
+}
+
+func (suite *WordServiceTestSuite) TestDiffRevisions_RevisionNotFound() {
+	// Given
+	wordID := primitive.NewObjectID()
+	suite.mockRevisions.EXPECT().FindRevision(wordID, 9).Return(nil, ErrRevisionNotFound)
+
+	// When
+	_, err := suite.service.DiffRevisions(wordID.Hex(), 9, 1)
+
+	// Then
+	suite.ErrorIs(err, ErrRevisionNotFound)
+}
+
+func (suite *WordServiceTestSuite) TestPatchWord_KeepsDeliveryState() {
+	// Given
+	now := time.Now()
+	deliveredAt := now.Add(-24 * time.Hour)
+	version := 4
+	existing := &Word{ID: primitive.NewObjectID(), Text: "test", EnglishMeaning: "a trial", IsDelivered: true, DeliveredAt: deliveredAt, Version: version}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+	suite.mockRevisions.EXPECT().FindLatestRevision(existing.ID).Return(&Revision{Number: 1, Content: existing.content()}, nil)
+	suite.mockRepo.EXPECT().UpdateWord(mock.MatchedBy(func(word *Word) bool {
+		return word.EnglishMeaning == "an exam" && word.Text == "test" && word.IsDelivered && word.DeliveredAt.Equal(deliveredAt)
+	})).Return(nil)
+	suite.mockRevisions.EXPECT().SaveRevision(mock.Anything).Return(&Revision{}, nil)
+
+	// When
+	patched, err := suite.service.PatchWord(existing.ID.Hex(), []byte(`{"english_meaning": "an exam"}`), &version, "editor@wordrop.com", now)
+
+	// Then
+	suite.NoError(err, "Expected no error when patching a word")
+	suite.Equal("an exam", patched.EnglishMeaning)
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func (suite *WordServiceTestSuite) TestPatchWord_StaleVersion() {
+	// Given
+	stale := 3
+	existing := &Word{ID: primitive.NewObjectID(), Text: "test", Version: 4}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+
+	// When
+	_, err := suite.service.PatchWord(existing.ID.Hex(), []byte(`{"english_meaning": "an exam"}`), &stale, "", time.Now())
+
+	// Then
+	suite.ErrorIs(err, ErrVersionConflict)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateWord", mock.Anything)
+}
+
+func (suite *WordServiceTestSuite) TestMarkDelivered_AfterConcurrentEdit() {
+	// Given
+	deliveredAt := time.Now()
+	picked := &Word{ID: primitive.NewObjectID(), Text: "test", EnglishMeaning: "a trial", Version: 1}
+	current := &Word{ID: picked.ID, Text: "test", EnglishMeaning: "an exam", Version: 2}
+	suite.mockRepo.EXPECT().UpdateWord(picked).Return(ErrVersionConflict).Once()
+	suite.mockRepo.EXPECT().FindById(picked.ID.Hex()).Return(current, nil)
+	suite.mockRepo.EXPECT().UpdateWord(current).Return(nil).Once()
+
+	// When
+	err := suite.service.MarkDelivered(picked, deliveredAt)
+
+	// Then
+	suite.NoError(err, "Expected a concurrent edit not to fail the delivery")
+	suite.True(current.IsDelivered)
+	suite.Equal("an exam", current.EnglishMeaning, "Expected the editor's change to be kept")
+}
+
+func (suite *WordServiceTestSuite) TestSaveNewWord_StartsAsDraft() {
+	// Given
+	suite.expectNoSynonymMatches()
+	suite.mockRepo.EXPECT().SaveWord(mock.MatchedBy(func(word *Word) bool {
+		return word.Status == StatusDraft
+	})).RunAndReturn(func(word *Word) (*Word, error) {
+		word.ID = primitive.NewObjectID()
+		return word, nil
+	})
+	suite.mockRevisions.EXPECT().FindLatestRevision(mock.Anything).Return(nil, ErrRevisionNotFound)
+	suite.mockRevisions.EXPECT().SaveRevision(mock.Anything).Return(&Revision{}, nil)
+
+	// When
+	saved, err := suite.service.SaveNewWord(&SaveWordDto{Text: "serendipity"})
+
+	// Then
+	suite.NoError(err)
+	suite.Equal(StatusDraft, saved.Status, "Expected new words to wait for review")
+}
+
+func (suite *WordServiceTestSuite) TestChangeStatus() {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		from    Status
+		to      Status
+		wantErr error
+	}{
+		{name: "Submit For Review", from: StatusDraft, to: StatusInReview},
+		{name: "Approve", from: StatusInReview, to: StatusApproved},
+		{name: "Send Back", from: StatusInReview, to: StatusDraft},
+		{name: "Skip Review", from: StatusDraft, to: StatusApproved, wantErr: ErrInvalidTransition},
+		{name: "Schedule By Hand", from: StatusApproved, to: StatusScheduled, wantErr: ErrInvalidTransition},
+		{name: "Reopen Delivered", from: StatusDelivered, to: StatusDraft, wantErr: ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			// Given
+			suite.SetupTest()
+			existing := &Word{ID: primitive.NewObjectID(), Text: "test", Status: tt.from, CreatedBy: "author@wordrop.com"}
+			suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil).Maybe()
+			suite.mockRepo.EXPECT().UpdateWord(existing).Return(nil).Maybe()
+
+			// When
+			changed, err := suite.service.ChangeStatus(existing.ID.Hex(), tt.to, "reviewer@wordrop.com", "", now)
+
+			// Then
+			if tt.wantErr != nil {
+				suite.ErrorIs(err, tt.wantErr)
+				suite.mockRepo.AssertNotCalled(suite.T(), "UpdateWord", mock.Anything)
+				return
+			}
+			suite.NoError(err)
+			suite.Equal(tt.to, changed.Status)
+		})
+	}
+}
+
+func (suite *WordServiceTestSuite) TestChangeStatus_ApprovalNeedsAnotherEditor() {
+	tests := []struct {
+		name      string
+		createdBy string
+		firstBy   string
+		reviewer  string
+		wantErr   error
+	}{
+		{name: "Own Word", createdBy: "author@wordrop.com", reviewer: "Author@wordrop.com", wantErr: ErrSelfApproval},
+		{name: "Own Word From Revision History", firstBy: "author@wordrop.com", reviewer: "author@wordrop.com", wantErr: ErrSelfApproval},
+		{name: "Nameless Reviewer", createdBy: "author@wordrop.com", wantErr: ErrReviewerRequired},
+		{name: "Another Editor From Revision History", firstBy: "author@wordrop.com", reviewer: "reviewer@wordrop.com"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			// Given
+			suite.SetupTest()
+			existing := &Word{ID: primitive.NewObjectID(), Text: "test", Status: StatusInReview, CreatedBy: tt.createdBy}
+			suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+			suite.mockRevisions.EXPECT().FindRevision(existing.ID, 1).Return(&Revision{Number: 1, Editor: tt.firstBy}, nil).Maybe()
+			suite.mockRepo.EXPECT().UpdateWord(existing).Return(nil).Maybe()
+
+			// When
+			_, err := suite.service.ChangeStatus(existing.ID.Hex(), StatusApproved, tt.reviewer, "", time.Now())
+
+			// Then
+			if tt.wantErr != nil {
+				suite.ErrorIs(err, tt.wantErr)
+				suite.mockRepo.AssertNotCalled(suite.T(), "UpdateWord", mock.Anything)
+				return
+			}
+			suite.NoError(err)
+		})
+	}
+}
+
+func (suite *WordServiceTestSuite) TestChangeStatus_KeepsReviewerComment() {
+	// Given
+	now := time.Now()
+	existing := &Word{ID: primitive.NewObjectID(), Text: "test", Status: StatusInReview}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+	suite.mockRepo.EXPECT().UpdateWord(existing).Return(nil)
+
+	// When
+	changed, err := suite.service.ChangeStatus(existing.ID.Hex(), StatusDraft, "reviewer@wordrop.com", " Add an example sentence. ", now)
+
+	// Then
+	suite.NoError(err)
+	suite.Equal([]Comment{{Author: "reviewer@wordrop.com", Text: "Add an example sentence.", Status: StatusDraft, CreatedAt: now}}, changed.Comments)
+}
+
+func (suite *WordServiceTestSuite) TestSetScheduled() {
+	tests := []struct {
+		name      string
+		from      Status
+		scheduled bool
+		want      Status
+	}{
+		{name: "Scheduled", from: StatusApproved, scheduled: true, want: StatusScheduled},
+		{name: "Unscheduled", from: StatusScheduled, scheduled: false, want: StatusApproved},
+		{name: "Already Delivered", from: StatusDelivered, scheduled: false, want: StatusDelivered},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			// Given
+			suite.SetupTest()
+			existing := &Word{ID: primitive.NewObjectID(), Status: tt.from}
+			suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+			suite.mockRepo.EXPECT().UpdateWord(existing).Return(nil).Maybe()
+
+			// When
+			err := suite.service.SetScheduled(existing.ID, tt.scheduled)
+
+			// Then
+			suite.NoError(err)
+			suite.Equal(tt.want, existing.Status)
+		})
+	}
+}
+
+func (suite *WordServiceTestSuite) TestRemoveAttachment() {
+	// Given
+	existing := &Word{ID: primitive.NewObjectID(), Attachments: []Attachment{
+		{ID: "picture", Key: "words/1/picture.png"},
+		{ID: "recording", Key: "words/1/recording.mp3"},
+	}}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+	suite.mockRepo.EXPECT().UpdateWord(existing).Return(nil).Once()
+
+	// When
+	removed, err := suite.service.RemoveAttachment(existing.ID.Hex(), "picture")
+	_, missingErr := suite.service.RemoveAttachment(existing.ID.Hex(), "picture")
+
+	// Then
+	suite.NoError(err, "Expected no error when removing an attachment")
+	suite.Equal("words/1/picture.png", removed.Key)
+	suite.Equal([]Attachment{{ID: "recording", Key: "words/1/recording.mp3"}}, existing.Attachments)
+	suite.ErrorIs(missingErr, ErrAttachmentNotFound)
+}
+
+func (suite *WordServiceTestSuite) TestSaveNewWord_LinksSynonyms() {
+	// Given
+	saved := &Word{ID: primitive.NewObjectID(), Text: "luck", Synonyms: []string{"fortune"}, CreatedAt: time.Now()}
+	fortune := &Word{ID: primitive.NewObjectID(), Text: "Fortune"}
+	serendipity := &Word{ID: primitive.NewObjectID(), Text: "serendipity", Synonyms: []string{"luck"}}
+	suite.mockRepo.EXPECT().SaveWord(mock.Anything).Return(saved, nil)
+	suite.mockRevisions.EXPECT().FindLatestRevision(saved.ID).Return(nil, ErrRevisionNotFound)
+	suite.mockRevisions.EXPECT().SaveRevision(mock.Anything).Return(&Revision{}, nil)
+	suite.mockRepo.EXPECT().FindByTexts([]string{"fortune"}).Return([]*Word{fortune}, nil)
+	suite.mockRepo.EXPECT().FindBySynonym("luck").Return([]*Word{serendipity}, nil)
+	suite.mockRelations.EXPECT().FindRelations(saved.ID).Return([]*Relation{
+		{ID: primitive.NewObjectID(), Type: RelationSynonym, FromID: serendipity.ID, ToID: saved.ID},
+	}, nil)
+	suite.mockRelations.EXPECT().SaveRelation(mock.MatchedBy(func(relation *Relation) bool {
+		return relation.Type == RelationSynonym && relation.Auto && relation.FromID == saved.ID && relation.ToID == fortune.ID
+	})).Return(&Relation{}, nil).Once()
+
+	// When
+	_, err := suite.service.SaveNewWord(&SaveWordDto{Text: "luck", Synonyms: []string{"fortune"}})
+
+	// Then
+	suite.NoError(err)
+	suite.mockRelations.AssertExpectations(suite.T())
+}
+
+func (suite *WordServiceTestSuite) TestUpdateWord_UnlinksRemovedSynonyms() {
+	// Given
+	existing := &Word{ID: primitive.NewObjectID(), Text: "luck", Synonyms: []string{"fortune"}}
+	auto := &Relation{ID: primitive.NewObjectID(), Type: RelationSynonym, FromID: existing.ID, ToID: primitive.NewObjectID(), Auto: true}
+	manual := &Relation{ID: primitive.NewObjectID(), Type: RelationSynonym, FromID: existing.ID, ToID: primitive.NewObjectID()}
+	suite.mockRepo.EXPECT().FindById(existing.ID.Hex()).Return(existing, nil)
+	suite.mockRevisions.EXPECT().FindLatestRevision(existing.ID).Return(&Revision{Number: 1}, nil)
+	suite.mockRepo.EXPECT().UpdateWord(existing).Return(nil)
+	suite.mockRevisions.EXPECT().SaveRevision(mock.Anything).Return(&Revision{}, nil)
+	suite.mockRepo.EXPECT().FindBySynonym("luck").Return([]*Word{}, nil)
+	suite.mockRelations.EXPECT().FindRelations(existing.ID).Return([]*Relation{auto, manual}, nil)
+	suite.mockRelations.EXPECT().DeleteRelation(auto.ID).Return(nil).Once()
+
+	// When
+	err := suite.service.UpdateWord(&UpdateWordDto{ID: existing.ID.Hex(), Text: "luck"})
+
+	// Then
+	suite.NoError(err)
+	suite.mockRelations.AssertExpectations(suite.T())
+}
+
+func (suite *WordServiceTestSuite) TestRelateWords() {
+	happy := &Word{ID: primitive.NewObjectID(), Text: "happy"}
+	happiness := &Word{ID: primitive.NewObjectID(), Text: "happiness"}
+	suite.mockRepo.EXPECT().FindById(happy.ID.Hex()).Return(happy, nil)
+	suite.mockRepo.EXPECT().FindById(happiness.ID.Hex()).Return(happiness, nil)
+
+	suite.Run("Base Form Is Stored As Derived", func() {
+		suite.mockRelations.EXPECT().FindRelations(happiness.ID).Return([]*Relation{}, nil).Once()
+		suite.mockRelations.EXPECT().SaveRelation(mock.MatchedBy(func(relation *Relation) bool {
+			return relation.Type == RelationDerived && relation.FromID == happy.ID && relation.ToID == happiness.ID
+		})).Return(&Relation{ID: primitive.NewObjectID()}, nil).Once()
+
+		related, err := suite.service.RelateWords(happiness.ID.Hex(), happy.ID.Hex(), RelationBaseForm, "editor@wordrop.com", time.Now())
+
+		suite.NoError(err)
+		suite.Equal(RelationBaseForm, related.Type)
+		suite.Equal("happy", related.Text)
+	})
+
+	suite.Run("Already Related", func() {
+		existing := &Relation{Type: RelationDerived, FromID: happy.ID, ToID: happiness.ID}
+		suite.mockRelations.EXPECT().FindRelations(happy.ID).Return([]*Relation{existing}, nil).Once()
+
+		_, err := suite.service.RelateWords(happy.ID.Hex(), happiness.ID.Hex(), RelationDerived, "", time.Now())
+
+		suite.ErrorIs(err, ErrRelationExists)
+	})
+
+	suite.Run("Itself", func() {
+		_, err := suite.service.RelateWords(happy.ID.Hex(), happy.ID.Hex(), RelationSynonym, "", time.Now())
+
+		suite.ErrorIs(err, ErrSelfRelation)
+	})
+}
+
+func (suite *WordServiceTestSuite) TestRelatedWords_SkipsDeletedWords() {
+	// Given
+	happy := &Word{ID: primitive.NewObjectID(), Text: "happy"}
+	happiness := &Word{ID: primitive.NewObjectID(), Text: "happiness"}
+	relations := []*Relation{
+		{ID: primitive.NewObjectID(), Type: RelationDerived, FromID: happy.ID, ToID: happiness.ID},
+		{ID: primitive.NewObjectID(), Type: RelationAntonym, FromID: primitive.NewObjectID(), ToID: happiness.ID},
+	}
+	suite.mockRepo.EXPECT().FindById(happiness.ID.Hex()).Return(happiness, nil)
+	suite.mockRelations.EXPECT().FindRelations(happiness.ID).Return(relations, nil)
+	suite.mockRepo.EXPECT().FindByIds([]primitive.ObjectID{happy.ID, relations[1].FromID}).Return([]*Word{happy}, nil)
+
+	// When
+	related, err := suite.service.RelatedWords(happiness.ID.Hex())
+
+	// Then
+	suite.NoError(err)
+	suite.Equal([]*RelatedWord{{RelationID: relations[0].ID, Type: RelationBaseForm, WordID: happy.ID, Text: "happy"}}, related)
+}
@@ -0,0 +1,77 @@
+package word
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Status is where a word is in the editorial workflow.
+type Status string
+
+const (
+	StatusDraft     Status = "draft"
+	StatusInReview  Status = "in_review"
+	StatusApproved  Status = "approved"
+	StatusScheduled Status = "scheduled"
+	StatusDelivered Status = "delivered"
+)
+
+var Statuses = []Status{StatusDraft, StatusInReview, StatusApproved, StatusScheduled, StatusDelivered}
+
+// transitions lists the statuses a word may move to from each status. A reviewer can send a word
+// under review back to draft, and a scheduled word goes back to approved when it leaves the schedule.
+var transitions = map[Status][]Status{
+	StatusDraft:     {StatusInReview},
+	StatusInReview:  {StatusApproved, StatusDraft},
+	StatusApproved:  {StatusScheduled, StatusDraft, StatusDelivered},
+	StatusScheduled: {StatusApproved, StatusDelivered},
+	StatusDelivered: {},
+}
+
+// editorialStatuses are the statuses editors set by hand; scheduled and delivered follow from the schedule and deliveries.
+var editorialStatuses = []Status{StatusDraft, StatusInReview, StatusApproved}
+
+// deliverableStatuses are the statuses of words that passed review. A word delivered to one subscriber
+// is still due to the others.
+var deliverableStatuses = []Status{StatusApproved, StatusScheduled, StatusDelivered}
+
+func (s Status) CanTransitionTo(to Status) bool {
+	return slices.Contains(transitions[s], to)
+}
+
+// Deliverable reports whether words in this status may be sent to subscribers.
+func (s Status) Deliverable() bool {
+	return slices.Contains(deliverableStatuses, s)
+}
+
+func ParseStatus(value string) (Status, error) {
+	status := Status(strings.ToLower(strings.TrimSpace(value)))
+	if slices.Contains(Statuses, status) {
+		return status, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidStatus, value)
+}
+
+func ParseStatuses(values []string) ([]Status, error) {
+	statuses := make([]Status, 0, len(values))
+	for _, value := range values {
+		status, err := ParseStatus(value)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(statuses, status) {
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses, nil
+}
+
+// Comment is a reviewer's note on a word, optionally left while moving it to another status.
+type Comment struct {
+	Author    string    `bson:"author"`
+	Text      string    `bson:"text"`
+	Status    Status    `bson:"status,omitempty"` // the status the word moved to with this comment, if any
+	CreatedAt time.Time `bson:"created_at"`
+}
@@ -1,15 +1,28 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/Go-roro/wordrop/cmd/web"
 	"github.com/Go-roro/wordrop/internal/auth"
+	"github.com/Go-roro/wordrop/internal/bounce"
+	"github.com/Go-roro/wordrop/internal/delivery"
+	"github.com/Go-roro/wordrop/internal/dictionary"
 	"github.com/Go-roro/wordrop/internal/infra/db"
 	"github.com/Go-roro/wordrop/internal/infra/email"
+	"github.com/Go-roro/wordrop/internal/media"
+	"github.com/Go-roro/wordrop/internal/quiz"
+	"github.com/Go-roro/wordrop/internal/review"
+	"github.com/Go-roro/wordrop/internal/schedule"
 	"github.com/Go-roro/wordrop/internal/subscription"
+	"github.com/Go-roro/wordrop/internal/tracking"
 	"github.com/Go-roro/wordrop/internal/word"
 	"github.com/joho/godotenv"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -18,6 +31,15 @@ import (
 const localPort string = ":8080"
 
 func main() {
+	bounceMbox := flag.String("bounce-mbox", "", "process bounce reports from the given mbox file and exit")
+	bounceImap := flag.Bool("bounce-imap", false, "process unseen bounce reports from the IMAP mailbox and exit")
+	sendQuizzes := flag.Bool("send-quizzes", false, "send the weekly quiz to every subscriber due one and exit, meant to be run weekly from cron")
+	enrichWord := flag.String("enrich", "", "print the dictionary enrichment proposed for the word with the given ID and exit")
+	previewTemplate := flag.String("preview-email", "", "render the named email template to a file and exit")
+	previewWord := flag.String("preview-word", "", "ID of the word to render the preview with instead of sample data")
+	previewOut := flag.String("preview-out", "", "preview output file, .txt writes the plain-text part (default <template>.html)")
+	flag.Parse()
+
 	err := godotenv.Load()
 	if err != nil {
 		log.Fatal("Error loading .env file")
@@ -25,17 +47,71 @@ func main() {
 
 	database := setupDatabase()
 	wordRepo := word.NewWordRepo(database)
-	wordService := word.NewWordService(wordRepo)
+	if err := wordRepo.EnsureIndexes(); err != nil {
+		log.Fatalf("Failed to create word indexes: %v", err)
+	}
+	wordRevisionRepo := word.NewRevisionRepo(database)
+	if err := wordRevisionRepo.EnsureIndexes(); err != nil {
+		log.Fatalf("Failed to create word revision indexes: %v", err)
+	}
+	scheduleRepo := schedule.NewScheduleRepo(database)
+	if err := scheduleRepo.EnsureIndexes(); err != nil {
+		log.Fatalf("Failed to create schedule indexes: %v", err)
+	}
+	wordRelationRepo := word.NewRelationRepo(database)
+	wordService := word.NewWordService(wordRepo, wordRevisionRepo, wordRelationRepo)
+
+	dictionaryService := dictionary.NewDictionaryService(setupDictionary(), wordService)
+	if *enrichWord != "" {
+		printEnrichment(dictionaryService, *enrichWord)
+		return
+	}
 
 	subscriptionRepo := subscription.NewSubscriptionRepo(database)
 	sender := setupMailSender()
+	if *previewTemplate != "" {
+		writePreview(sender, wordService, *previewTemplate, *previewWord, *previewOut)
+		return
+	}
+
 	provider, err := auth.NewJwtProvider(os.Getenv("JWT_SECRET_KEY"))
 	if err != nil {
 		log.Fatalf("Failed to create JWT provider: %v", err)
 	}
 	subscriptionService := subscription.NewSubscriptionService(subscriptionRepo, sender, provider)
+	mediaService := media.NewMediaService(setupMediaStorage(provider), wordService)
+
+	if *bounceMbox != "" || *bounceImap {
+		processBounces(subscriptionService, *bounceMbox)
+		return
+	}
+
+	trackingRepo := tracking.NewTrackingRepo(database)
+	trackingService := tracking.NewTrackingService(trackingRepo, subscriptionService, provider)
+	sender.SetTracker(trackingService)
+
+	deliveryRepo := delivery.NewDeliveryRepo(database)
+	reviewRepo := review.NewReviewRepo(database)
+	reviewService := review.NewReviewService(reviewRepo, wordService, provider)
+	scheduleService := schedule.NewScheduleService(scheduleRepo, wordService)
+	deliveryService := delivery.NewDeliveryService(deliveryRepo, subscriptionService, wordService, scheduleService, reviewService, sender)
+	quizRepo := quiz.NewQuizRepo(database)
+	quizService := quiz.NewQuizService(quizRepo, subscriptionService, deliveryService, wordService, sender, provider)
+	if *sendQuizzes {
+		sent, err := quizService.SendWeeklyQuizzes(time.Now())
+		if err != nil {
+			log.Fatalf("Failed to send weekly quizzes: %v", err)
+		}
+		log.Printf("✅ Sent %d weekly quizzes", sent)
+		return
+	}
+
+	// Only the server migrates words, so the commands above never change stored data they do not work on.
+	migrateWords(wordService)
 
-	r := web.SetupRouter(wordService, subscriptionService)
+	go delivery.NewScheduler(deliveryService, delivery.DefaultInterval).Run(context.Background())
+
+	r := web.SetupRouter(wordService, subscriptionService, trackingService, deliveryService, reviewService, quizService, scheduleService, dictionaryService, mediaService, sender)
 	log.Printf("Starting server on %s\n", localPort)
 
 	if err := http.ListenAndServe(localPort, r); err != nil {
@@ -43,6 +119,19 @@ func main() {
 	}
 }
 
+func migrateWords(wordService *word.Service) {
+	if migrated, err := wordService.MigrateStatuses(); err != nil {
+		log.Fatalf("Failed to migrate word statuses: %v", err)
+	} else if migrated > 0 {
+		log.Printf("Gave %d words saved before the editorial workflow a status", migrated)
+	}
+	if migrated, err := wordService.MigrateSenses(); err != nil {
+		log.Fatalf("Failed to migrate word senses: %v", err)
+	} else if migrated > 0 {
+		log.Printf("Gave %d words saved before senses their senses", migrated)
+	}
+}
+
 func setupMailSender() *email.GmailSender {
 	config, err := email.NewMailSenderConfig()
 	if err != nil {
@@ -55,6 +144,68 @@ func setupMailSender() *email.GmailSender {
 	return sender
 }
 
+// setupDictionary looks words up in the WordNet files in WORDNET_DIR when set, then in the Free Dictionary
+// API at DICTIONARY_API_URL, which defaults to the public one.
+func setupDictionary() dictionary.Provider {
+	var providers dictionary.Chain
+	if dir := os.Getenv("WORDNET_DIR"); dir != "" {
+		wordNet, err := dictionary.NewWordNet(dir)
+		if err != nil {
+			log.Fatalf("Failed to load WordNet: %v", err)
+		}
+		providers = append(providers, wordNet)
+	}
+
+	apiURL := os.Getenv("DICTIONARY_API_URL")
+	if apiURL == "" {
+		apiURL = dictionary.DefaultFreeDictionaryURL
+	}
+	return append(providers, dictionary.NewFreeDictionary(apiURL))
+}
+
+// setupMediaStorage keeps uploaded media in the S3-compatible bucket configured by the S3_* variables when
+// MEDIA_STORAGE is "s3", and otherwise in MEDIA_DIR, served by this server at MEDIA_BASE_URL.
+func setupMediaStorage(provider *auth.JwtProvider) media.Storage {
+	if os.Getenv("MEDIA_STORAGE") == "s3" {
+		config, err := media.NewS3Config()
+		if err != nil {
+			log.Fatalf("Failed to create S3Config: %v", err)
+		}
+		storage, err := media.NewS3Storage(config)
+		if err != nil {
+			log.Fatalf("Failed to create S3 media storage: %v", err)
+		}
+		return storage
+	}
+
+	dir := os.Getenv("MEDIA_DIR")
+	if dir == "" {
+		dir = "media"
+	}
+	baseURL := os.Getenv("MEDIA_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost" + localPort
+	}
+	storage, err := media.NewLocalStorage(dir, baseURL, provider)
+	if err != nil {
+		log.Fatalf("Failed to create local media storage: %v", err)
+	}
+	return storage
+}
+
+func printEnrichment(dictionaryService *dictionary.Service, wordID string) {
+	proposal, err := dictionaryService.Propose(wordID)
+	if err != nil {
+		log.Fatalf("Failed to propose enrichment for word %s: %v", wordID, err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(proposal); err != nil {
+		log.Fatalf("Failed to print proposal: %v", err)
+	}
+}
+
 func setupDatabase() *mongo.Database {
 	database, err := db.NewMongoDatabase("mongodb://localhost:27017", "wordrop")
 	if err != nil {
@@ -62,3 +213,60 @@ func setupDatabase() *mongo.Database {
 	}
 	return database
 }
+
+func processBounces(subscriptionService *subscription.Service, mboxPath string) {
+	var suspended int
+	if mboxPath != "" {
+		file, err := os.Open(mboxPath)
+		if err != nil {
+			log.Fatalf("Failed to open mbox: %v", err)
+		}
+		defer file.Close()
+
+		notifications, err := bounce.ParseMbox(file)
+		if err != nil {
+			log.Fatalf("Failed to parse mbox: %v", err)
+		}
+		if suspended, err = bounce.Apply(subscriptionService, notifications); err != nil {
+			log.Fatalf("Failed to apply bounce reports: %v", err)
+		}
+	} else {
+		config, err := bounce.NewImapConfig()
+		if err != nil {
+			log.Fatalf("Failed to create ImapConfig: %v", err)
+		}
+		if suspended, err = bounce.ProcessImap(config, subscriptionService); err != nil {
+			log.Fatalf("Failed to process IMAP bounce reports: %v", err)
+		}
+	}
+	log.Printf("✅ Suspended %d subscriptions from bounce reports", suspended)
+}
+
+func writePreview(sender *email.GmailSender, wordService *word.Service, templateName, wordID, outPath string) {
+	var dailyWord *word.Word
+	if wordID != "" {
+		found, err := wordService.FindWord(wordID)
+		if err != nil {
+			log.Fatalf("Failed to find word %s: %v", wordID, err)
+		}
+		dailyWord = found
+	}
+
+	preview, err := sender.RenderPreview(templateName, dailyWord)
+	if err != nil {
+		log.Fatalf("Failed to render preview (available templates: %v): %v", email.TemplateNames(), err)
+	}
+
+	if outPath == "" {
+		outPath = templateName + ".html"
+	}
+	content := preview.HTML
+	if filepath.Ext(outPath) == ".txt" {
+		content = preview.Text
+	}
+
+	if err := os.WriteFile(outPath, []byte(content), 0o644); err != nil {
+		log.Fatalf("Failed to write preview: %v", err)
+	}
+	log.Printf("✅ Preview of %s written to %s", templateName, outPath)
+}